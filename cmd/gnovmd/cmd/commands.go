@@ -25,6 +25,8 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
 	"github.com/ignite/gnovm/app"
+	gnovmclient "github.com/ignite/gnovm/x/gnovm/client"
+	gnovmtypes "github.com/ignite/gnovm/x/gnovm/types"
 )
 
 func initRootCmd(
@@ -45,11 +47,15 @@ func initRootCmd(
 	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{
 		AddFlags: addModuleInitFlags,
 	})
+	addModuleExportFlags(rootCmd)
+
+	genesisCmd := genutilcli.Commands(txConfig, basicManager, app.DefaultNodeHome)
+	genesisCmd.AddCommand(gnovmclient.NewAddGenesisPackageCmd(app.DefaultNodeHome, txConfig.SigningContext().AddressCodec()))
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genutilcli.Commands(txConfig, basicManager, app.DefaultNodeHome),
+		genesisCmd,
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
@@ -60,6 +66,15 @@ func initRootCmd(
 func addModuleInitFlags(startCmd *cobra.Command) {
 }
 
+// addModuleExportFlags adds more flags to the export command.
+func addModuleExportFlags(rootCmd *cobra.Command) {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "export" {
+			cmd.Flags().String(gnovmtypes.FlagExportFormat, string(gnovmtypes.ExportFormatKV), "Format of the gnovm state, either kv (raw store dump) or packages (packages sources and objects)")
+		}
+	}
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -14,10 +14,12 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	cmtabcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/integration"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -159,6 +161,30 @@ func initFixture(t testing.TB) *fixture {
 	}
 }
 
+// deliverMsg runs msg in a new block. Unlike RunMsg, the message is delivered
+// rather than checked, so that the VM keeps the nodes of the transaction.
+func (f *fixture) deliverMsg(msg sdk.Msg) (*codectypes.Any, error) {
+	if _, err := f.app.FinalizeBlock(&cmtabcitypes.RequestFinalizeBlock{Height: f.app.LastBlockHeight() + 1}); err != nil {
+		return nil, fmt.Errorf("failed to run finalize block: %w", err)
+	}
+	defer f.app.Commit() //nolint:errcheck // not needed in testing
+
+	handler := f.app.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return nil, fmt.Errorf("no handler for message %s", sdk.MsgTypeURL(msg))
+	}
+
+	res, err := handler(f.ctx.WithExecMode(sdk.ExecModeFinalize), msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute message %s: %w", sdk.MsgTypeURL(msg), err)
+	}
+	if len(res.MsgResponses) == 0 {
+		return nil, nil
+	}
+
+	return res.MsgResponses[0], nil
+}
+
 // TestBankerContract_DeployAndDeposit tests deploying the banker contract and depositing coins
 func TestBankerContract_DeployAndDeposit(t *testing.T) {
 	t.Parallel()
@@ -201,11 +227,7 @@ func TestBankerContract_DeployAndDeposit(t *testing.T) {
 		pkgBz,
 	)
 
-	_, err = f.app.RunMsg(
-		msgAddPackage,
-		integration.WithAutomaticFinalizeBlock(),
-		integration.WithAutomaticCommit(),
-	)
+	_, err = f.deliverMsg(msgAddPackage)
 	require.NoError(t, err, "failed to add banker package")
 
 	// Verify deployer balance decreased by deposit
@@ -224,11 +246,7 @@ func TestBankerContract_DeployAndDeposit(t *testing.T) {
 		[]string{},
 	)
 
-	res, err := f.app.RunMsg(
-		msgCall,
-		integration.WithAutomaticFinalizeBlock(),
-		integration.WithAutomaticCommit(),
-	)
+	res, err := f.deliverMsg(msgCall)
 	require.NoError(t, err, "failed to call Deposit function")
 
 	// Verify response contains success message
@@ -267,11 +285,7 @@ func TestBankerContract_SendCoins(t *testing.T) {
 		pkgBz,
 	)
 
-	_, err = f.app.RunMsg(
-		msgAddPackage,
-		integration.WithAutomaticFinalizeBlock(),
-		integration.WithAutomaticCommit(),
-	)
+	_, err = f.deliverMsg(msgAddPackage)
 	require.NoError(t, err)
 
 	// Deposit coins to realm
@@ -285,11 +299,7 @@ func TestBankerContract_SendCoins(t *testing.T) {
 		[]string{},
 	)
 
-	_, err = f.app.RunMsg(
-		msgDeposit,
-		integration.WithAutomaticFinalizeBlock(),
-		integration.WithAutomaticCommit(),
-	)
+	_, err = f.deliverMsg(msgDeposit)
 	require.NoError(t, err)
 
 	// Get recipient balance before transfer
@@ -310,11 +320,7 @@ func TestBankerContract_SendCoins(t *testing.T) {
 		[]string{toAddr, fmt.Sprintf("%d", transferAmount), testDenom},
 	)
 
-	res, err := f.app.RunMsg(
-		msgSendCoins,
-		integration.WithAutomaticFinalizeBlock(),
-		integration.WithAutomaticCommit(),
-	)
+	res, err := f.deliverMsg(msgSendCoins)
 	require.NoError(t, err, "failed to call SendCoins function")
 
 	// Verify response
@@ -356,11 +362,7 @@ func TestBankerContract_QueryBalance(t *testing.T) {
 		pkgBz,
 	)
 
-	_, err = f.app.RunMsg(
-		msgAddPackage,
-		integration.WithAutomaticFinalizeBlock(),
-		integration.WithAutomaticCommit(),
-	)
+	_, err = f.deliverMsg(msgAddPackage)
 	require.NoError(t, err)
 
 	// Deposit coins to realm
@@ -374,11 +376,7 @@ func TestBankerContract_QueryBalance(t *testing.T) {
 		[]string{},
 	)
 
-	_, err = f.app.RunMsg(
-		msgDeposit,
-		integration.WithAutomaticFinalizeBlock(),
-		integration.WithAutomaticCommit(),
-	)
+	_, err = f.deliverMsg(msgDeposit)
 	require.NoError(t, err)
 
 	// Query realm balance
@@ -390,11 +388,7 @@ func TestBankerContract_QueryBalance(t *testing.T) {
 		[]string{},
 	)
 
-	res, err := f.app.RunMsg(
-		msgGetBalance,
-		integration.WithAutomaticFinalizeBlock(),
-		integration.WithAutomaticCommit(),
-	)
+	res, err := f.deliverMsg(msgGetBalance)
 	require.NoError(t, err, "failed to call GetBalance function")
 
 	// Verify response contains the deposited amount
@@ -434,11 +428,7 @@ func TestBankerContract_MultipleTransfers(t *testing.T) {
 		pkgBz,
 	)
 
-	_, err = f.app.RunMsg(
-		msgAddPackage,
-		integration.WithAutomaticFinalizeBlock(),
-		integration.WithAutomaticCommit(),
-	)
+	_, err = f.deliverMsg(msgAddPackage)
 	require.NoError(t, err)
 
 	// Deposit large amount to realm
@@ -452,11 +442,7 @@ func TestBankerContract_MultipleTransfers(t *testing.T) {
 		[]string{},
 	)
 
-	_, err = f.app.RunMsg(
-		msgDeposit,
-		integration.WithAutomaticFinalizeBlock(),
-		integration.WithAutomaticCommit(),
-	)
+	_, err = f.deliverMsg(msgDeposit)
 	require.NoError(t, err)
 
 	// Get initial balances
@@ -485,11 +471,7 @@ func TestBankerContract_MultipleTransfers(t *testing.T) {
 			[]string{toAddr, fmt.Sprintf("%d", transfer.amount), testDenom},
 		)
 
-		_, err = f.app.RunMsg(
-			msgSend,
-			integration.WithAutomaticFinalizeBlock(),
-			integration.WithAutomaticCommit(),
-		)
+		_, err = f.deliverMsg(msgSend)
 		require.NoError(t, err)
 	}
 
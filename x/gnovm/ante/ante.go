@@ -25,6 +25,7 @@ func (gnoAnteHandler) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 		switch {
 		case sdk.MsgTypeURL(msg) == sdk.MsgTypeURL(&types.MsgRun{}) ||
 			sdk.MsgTypeURL(msg) == sdk.MsgTypeURL(&types.MsgAddPackage{}) ||
+			sdk.MsgTypeURL(msg) == sdk.MsgTypeURL(&types.MsgAddPackages{}) ||
 			sdk.MsgTypeURL(msg) == sdk.MsgTypeURL(&types.MsgCall{}):
 			gnoVMCount++
 		default:
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/address"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+const (
+	flagCreator  = "creator"
+	flagInitFunc = "init-func"
+	flagInitArgs = "init-args"
+)
+
+// NewAddGenesisPackageCmd returns a CLI command handler for adding a gno package to genesis.json.
+func NewAddGenesisPackageCmd(defaultNodeHome string, addressCodec address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-gno-package [pkgFolder] --creator [address_or_key_name] --init-func [function] --init-args [arg]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add a gno package to genesis.json",
+		Long: `Add a gno package to genesis.json. The package is deployed by the creator at genesis,
+after the packages previously added. If a key name is given as creator, the address will be
+looked up in the local Keybase. An init function can optionally be called once the package is deployed.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			creatorStr, err := cmd.Flags().GetString(flagCreator)
+			if err != nil {
+				return err
+			}
+			creator, err := addressCodec.StringToBytes(creatorStr)
+			if err != nil {
+				kr := clientCtx.Keyring
+				if kr == nil {
+					keyringBackend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+					kr, err = keyring.New(sdk.KeyringServiceName(), keyringBackend, clientCtx.HomeDir, bufio.NewReader(cmd.InOrStdin()), clientCtx.Codec)
+					if err != nil {
+						return err
+					}
+				}
+
+				k, err := kr.Key(creatorStr)
+				if err != nil {
+					return fmt.Errorf("failed to get address from Keyring: %w", err)
+				}
+
+				creator, err = k.GetAddress()
+				if err != nil {
+					return err
+				}
+			}
+			creatorStr, err = addressCodec.BytesToString(creator)
+			if err != nil {
+				return err
+			}
+
+			memPkg, err := readPackage(args[0])
+			if err != nil {
+				return err
+			}
+
+			var initCall *types.GenesisPackageCall
+			initFunc, err := cmd.Flags().GetString(flagInitFunc)
+			if err != nil {
+				return err
+			}
+			if initFunc != "" {
+				initArgs, err := cmd.Flags().GetStringArray(flagInitArgs)
+				if err != nil {
+					return err
+				}
+				initCall = &types.GenesisPackageCall{Function: initFunc, Args: initArgs}
+			}
+
+			genesisPkg, err := types.NewGenesisPackage(creatorStr, memPkg, initCall)
+			if err != nil {
+				return err
+			}
+
+			return addGenesisPackage(clientCtx, config.GenesisFile(), genesisPkg)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagCreator, "", "Address or key name of the package creator")
+	cmd.Flags().String(flagInitFunc, "", "Function to call once the package is deployed")
+	cmd.Flags().StringArray(flagInitArgs, nil, "Arguments of the init function (can be repeated)")
+	_ = cmd.MarkFlagRequired(flagCreator)
+
+	return cmd
+}
+
+// addGenesisPackage appends the package to the gnovm genesis packages of the genesis file.
+func addGenesisPackage(clientCtx client.Context, genFile string, pkg types.GenesisPackage) error {
+	appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	var genState types.GenesisState
+	if err := clientCtx.Codec.UnmarshalJSON(appState[types.ModuleName], &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	genState.GenesisPackages = append(genState.GenesisPackages, pkg)
+	if err := genState.Validate(); err != nil {
+		return err
+	}
+
+	genStateBz, err := clientCtx.Codec.MarshalJSON(&genState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", types.ModuleName, err)
+	}
+	appState[types.ModuleName] = genStateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	appGenesis.AppState = appStateJSON
+	return genutil.ExportGenesisFile(appGenesis, genFile)
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/gnovm/pkg/gnomod"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// readPackage reads the package of the given folder.
+func readPackage(folder string) (*std.MemPackage, error) {
+	folderPath, err := filepath.Abs(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	gnoMod, err := gnomod.ParseDir(folderPath)
+	if err != nil {
+		return nil, err
+	}
+
+	memPkg, err := gnolang.ReadMemPackage(folderPath, gnoMod.Module, gnolang.MPAnyAll)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package: %w", err)
+	}
+
+	return memPkg, nil
+}
+
+// readPackages reads the packages of the given folder and its sub-folders,
+// i.e. the folders with a gnomod.toml file, skipping the ignored modules.
+func readPackages(root string) ([]*std.MemPackage, error) {
+	var memPkgs []*std.MemPackage
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return err
+		}
+		if _, err := os.Stat(filepath.Join(path, "gnomod.toml")); err != nil {
+			return nil
+		}
+
+		gnoMod, err := gnomod.ParseDir(path)
+		if err != nil {
+			return err
+		}
+		if gnoMod.Ignore {
+			return nil
+		}
+
+		memPkg, err := readPackage(path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		memPkgs = append(memPkgs, memPkg)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(memPkgs) == 0 {
+		return nil, fmt.Errorf("no package found in %s", root)
+	}
+
+	return memPkgs, nil
+}
@@ -1,9 +1,9 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"path/filepath"
 
 	"github.com/spf13/cobra"
 
@@ -12,8 +12,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/gnolang/gno/gnovm/pkg/gnolang"
-	"github.com/gnolang/gno/gnovm/pkg/gnomod"
 
 	"github.com/ignite/gnovm/x/gnovm/types"
 )
@@ -21,6 +19,9 @@ import (
 const (
 	flagSend       = "send"
 	flagMaxDeposit = "max-deposit"
+	flagJSONArgs   = "json-args"
+	flagRecursive  = "recursive"
+	flagAdmin      = "admin"
 )
 
 // NewTxCmd returns a root CLI command handler for gnovm transaction commands with a better UX than with AutoCLI.
@@ -37,18 +38,25 @@ func NewTxCmd(addressCodec address.Codec) *cobra.Command {
 		NewAddPackageCmd(addressCodec),
 		NewCallCmd(addressCodec),
 		NewRunCmd(addressCodec),
+		NewUpgradePackageCmd(addressCodec),
 	)
 
 	return rootCmd
 }
 
-// NewAddPackageCmd returns a CLI command handler for creating a MsgAddPackage transaction.
+// NewAddPackageCmd returns a CLI command handler for creating a MsgAddPackage transaction,
+// or a MsgAddPackages transaction when adding the packages of a folder tree.
 func NewAddPackageCmd(addressCodec address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "add-package [pkgFolder] --max-deposit [coins] --send [coins] --from creator",
+		Use:   "add-package [pkgFolder] --max-deposit [coins] --send [coins] --admin [address] --recursive --from creator",
 		Args:  cobra.ExactArgs(1),
 		Short: "Add a new package to the GnoVM",
-		Long:  "Add a new package to the GnoVM. Currently only one package can be added at a time.",
+		Long: `Add a new package to the GnoVM.
+
+With --recursive, all the packages found in the folder tree are added in the same transaction,
+in the order of their imports. Coins cannot be sent to the packages in that case.
+
+With --admin, the given address can upgrade the added packages, in addition to the creator.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -60,45 +68,72 @@ func NewAddPackageCmd(addressCodec address.Codec) *cobra.Command {
 				return err
 			}
 
-			folderPath, err := filepath.Abs(args[0])
+			maxDepositStr, err := cmd.Flags().GetString(flagMaxDeposit)
 			if err != nil {
 				return err
 			}
-
-			gnoMod, err := gnomod.ParseDir(folderPath)
+			maxDeposit, err := sdk.ParseCoinsNormalized(maxDepositStr)
 			if err != nil {
 				return err
 			}
 
-			memPkg, err := gnolang.ReadMemPackage(folderPath, gnoMod.Module, gnolang.MPAnyAll)
+			sendStr, err := cmd.Flags().GetString(flagSend)
 			if err != nil {
-				return fmt.Errorf("failed to read package")
+				return err
 			}
-
-			pkgJSON, err := json.Marshal(memPkg)
+			send, err := sdk.ParseCoinsNormalized(sendStr)
 			if err != nil {
-				return fmt.Errorf("failed to marshal package: %v", err)
+				return err
 			}
 
-			maxDepositStr, err := cmd.Flags().GetString(flagMaxDeposit)
+			admin, err := cmd.Flags().GetString(flagAdmin)
 			if err != nil {
 				return err
 			}
-			maxDeposit, err := sdk.ParseCoinsNormalized(maxDepositStr)
+			if admin != "" {
+				if _, err := addressCodec.StringToBytes(admin); err != nil {
+					return fmt.Errorf("invalid admin address: %w", err)
+				}
+			}
+
+			recursive, err := cmd.Flags().GetBool(flagRecursive)
 			if err != nil {
 				return err
 			}
+			if recursive {
+				if !send.IsZero() {
+					return fmt.Errorf("--%s and --%s cannot be used together", flagSend, flagRecursive)
+				}
+
+				memPkgs, err := readPackages(args[0])
+				if err != nil {
+					return err
+				}
+				msg, err := types.NewMsgAddPackages(creator, maxDeposit, memPkgs)
+				if err != nil {
+					return err
+				}
+				msg.Admin = admin
+				// fail early on import cycles
+				if _, err := msg.MemPackages(); err != nil {
+					return err
+				}
+
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
 
-			sendStr, err := cmd.Flags().GetString(flagSend)
+			memPkg, err := readPackage(args[0])
 			if err != nil {
 				return err
 			}
-			send, err := sdk.ParseCoinsNormalized(sendStr)
+
+			pkgJSON, err := json.Marshal(memPkg)
 			if err != nil {
-				return err
+				return fmt.Errorf("failed to marshal package: %v", err)
 			}
 
 			msg := types.NewMsgAddPackage(creator, send, maxDeposit, pkgJSON)
+			msg.Admin = admin
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -107,6 +142,8 @@ func NewAddPackageCmd(addressCodec address.Codec) *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(flagSend, "", "Coins to send along with the package")
 	cmd.Flags().String(flagMaxDeposit, "", "Maximum amount of coins to be spent for the storage fee (if empty the VM will use a default value)")
+	cmd.Flags().String(flagAdmin, "", "Address allowed to upgrade the packages, in addition to the creator")
+	cmd.Flags().BoolP(flagRecursive, "r", false, "Add all the packages of the folder tree, in the order of their imports")
 
 	return cmd
 }
@@ -114,7 +151,7 @@ func NewAddPackageCmd(addressCodec address.Codec) *cobra.Command {
 // NewCallCmd returns a CLI command handler for creating a MsgCall transaction.
 func NewCallCmd(addressCodec address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "call [pkgPath] [function] [args] --max-deposit [coins] --send [coins] --from caller",
+		Use:   "call [pkgPath] [function] [args] --max-deposit [coins] --send [coins] --json-args [json] --from caller",
 		Args:  cobra.MinimumNArgs(2),
 		Short: "Call a package on the GnoVM",
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -146,10 +183,26 @@ func NewCallCmd(addressCodec address.Codec) *cobra.Command {
 				return err
 			}
 
+			jsonArgs, err := cmd.Flags().GetString(flagJSONArgs)
+			if err != nil {
+				return err
+			}
+			if jsonArgs != "" && len(args) > 2 {
+				return fmt.Errorf("args and --%s cannot be used together", flagJSONArgs)
+			}
+
 			pkgPath := args[0]
 			function := args[1]
 
+			// the functions of the realm cannot be queried offline
+			if !clientCtx.Offline {
+				if err := validateCall(cmd.Context(), clientCtx, pkgPath, function, args[2:], jsonArgs); err != nil {
+					return err
+				}
+			}
+
 			msg := types.NewMsgCall(caller, send, maxDeposit, pkgPath, function, args[2:])
+			msg.JsonArgs = jsonArgs
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -157,10 +210,32 @@ func NewCallCmd(addressCodec address.Codec) *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(flagSend, "", "Coins to send along with the package")
 	cmd.Flags().String(flagMaxDeposit, "", "Maximum amount of coins to be spent for the storage fee (if empty the VM will use a default value)")
+	cmd.Flags().String(flagJSONArgs, "", "Arguments as a JSON array, for the functions with parameters of composite types")
 
 	return cmd
 }
 
+// validateCall checks the function and its arguments against the signatures
+// of the functions of the realm, before broadcasting the call.
+func validateCall(ctx context.Context, clientCtx client.Context, pkgPath, function string, args []string, jsonArgs string) error {
+	res, err := types.NewQueryClient(clientCtx).Funcs(ctx, &types.QueryFuncsRequest{PkgPath: pkgPath})
+	if err != nil {
+		return fmt.Errorf("failed to query functions of %s: %w", pkgPath, err)
+	}
+
+	for _, fsig := range res.Functions {
+		if fsig.Name != function {
+			continue
+		}
+		if jsonArgs != "" {
+			return fsig.ValidateJSONArgs(jsonArgs)
+		}
+		return fsig.ValidateCallArgs(args)
+	}
+
+	return fmt.Errorf("function %s not found in %s", function, pkgPath)
+}
+
 // NewRunCmd returns a CLI command handler for creating a MsgRun transaction.
 func NewRunCmd(addressCodec address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -178,35 +253,78 @@ func NewRunCmd(addressCodec address.Codec) *cobra.Command {
 				return err
 			}
 
-			folderPath, err := filepath.Abs(args[0])
+			memPkg, err := readPackage(args[0])
 			if err != nil {
 				return err
 			}
 
-			gnoMod, err := gnomod.ParseDir(folderPath)
+			pkgJSON, err := json.Marshal(memPkg)
+			if err != nil {
+				return fmt.Errorf("failed to marshal package: %v", err)
+			}
+
+			sendStr, err := cmd.Flags().GetString(flagSend)
+			if err != nil {
+				return err
+			}
+			send, err := sdk.ParseCoinsNormalized(sendStr)
 			if err != nil {
 				return err
 			}
 
-			memPkg, err := gnolang.ReadMemPackage(folderPath, gnoMod.Module, gnolang.MPAnyAll)
+			maxDepositStr, err := cmd.Flags().GetString(flagMaxDeposit)
 			if err != nil {
-				return fmt.Errorf("failed to read package")
+				return err
+			}
+			maxDeposit, err := sdk.ParseCoinsNormalized(maxDepositStr)
+			if err != nil {
+				return err
 			}
 
-			pkgJSON, err := json.Marshal(memPkg)
+			msg := types.NewMsgRun(caller, send, maxDeposit, pkgJSON)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagSend, "", "Coins to send along with the package")
+	cmd.Flags().String(flagMaxDeposit, "", "Maximum amount of coins to be spent for the storage fee (if empty the VM will use a default value)")
+
+	return cmd
+}
+
+// NewUpgradePackageCmd returns a CLI command handler for creating a MsgUpgradePackage transaction.
+func NewUpgradePackageCmd(addressCodec address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-package [pkgFolder] --max-deposit [coins] --from sender",
+		Args:  cobra.ExactArgs(1),
+		Short: "Upgrade the code of a package of the GnoVM",
+		Long: `Upgrade the code of a package of the GnoVM, keeping the state of the realm.
+
+Only the creator of the package, its admin and the governance can upgrade it.
+The upgrade is rejected if it removes or changes the type of a package variable or a declared type.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
-				return fmt.Errorf("failed to marshal package: %v", err)
+				return err
 			}
 
-			sendStr, err := cmd.Flags().GetString(flagSend)
+			sender, err := addressCodec.BytesToString(clientCtx.GetFromAddress())
 			if err != nil {
 				return err
 			}
-			send, err := sdk.ParseCoinsNormalized(sendStr)
+
+			memPkg, err := readPackage(args[0])
 			if err != nil {
 				return err
 			}
 
+			pkgJSON, err := json.Marshal(memPkg)
+			if err != nil {
+				return fmt.Errorf("failed to marshal package: %v", err)
+			}
+
 			maxDepositStr, err := cmd.Flags().GetString(flagMaxDeposit)
 			if err != nil {
 				return err
@@ -216,14 +334,13 @@ func NewRunCmd(addressCodec address.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgRun(caller, send, maxDeposit, pkgJSON)
+			msg := types.NewMsgUpgradePackage(sender, maxDeposit, pkgJSON)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(flagSend, "", "Coins to send along with the package")
 	cmd.Flags().String(flagMaxDeposit, "", "Maximum amount of coins to be spent for the storage fee (if empty the VM will use a default value)")
 
 	return cmd
@@ -0,0 +1,533 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	_ "unsafe" // for go:linkname
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	gnostdlibs "github.com/gnolang/gno/gnovm/stdlibs"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	gnosdk "github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// The VM only converts the string arguments of MsgCall to builtin types, and
+// keeps the transaction store of the gno context, the storage deposit and the
+// recovery of the machine panics private. They are linked here so that the
+// calls with JSON arguments run exactly as those of VMKeeper.Call.
+
+//go:linkname getGnoTransactionStore github.com/gnolang/gno/gno.land/pkg/sdk/vm.(*VMKeeper).getGnoTransactionStore
+func getGnoTransactionStore(vmk *vm.VMKeeper, ctx gnosdk.Context) gno.TransactionStore
+
+//go:linkname processStorageDeposit github.com/gnolang/gno/gno.land/pkg/sdk/vm.(*VMKeeper).processStorageDeposit
+func processStorageDeposit(vmk *vm.VMKeeper, ctx gnosdk.Context, caller crypto.Address, deposit std.Coins, gnostore gno.Store, params vm.Params) error
+
+//go:linkname doRecover github.com/gnolang/gno/gno.land/pkg/sdk/vm.doRecover
+func doRecover(m *gno.Machine, e *error)
+
+// callWithJSONArgs calls a crossing function as VMKeeper.Call does, with the
+// arguments decoded from a JSON array against the types of its parameters
+// rather than converted from strings, so that they can be of composite types.
+func (k msgServer) callWithJSONArgs(gnoCtx gnosdk.Context, msg vm.MsgCall, jsonArgs string) (res string, err error) {
+	params := k.VMKeeper.GetParams(gnoCtx)
+	gnostore := getGnoTransactionStore(k.VMKeeper, gnoCtx)
+	// Get the package and function type.
+	pv := gnostore.GetPackage(msg.PkgPath, false)
+	pn := gnostore.GetBlockNode(gno.PackageNodeLocation(msg.PkgPath)).(*gno.PackageNode)
+	ft, err := crossingFuncType(gnostore, pn, msg.Func)
+	if err != nil {
+		return "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Make main Package with imports.
+	mpn := gno.NewPackageNode("main", "", nil)
+	mpn.Define("pkg", gno.TypedValue{T: &gno.PackageType{}, V: pv})
+	mpv := mpn.NewPackage(gnostore.GetAllocator())
+	// Make context.
+	pkgAddr := gno.DerivePkgCryptoAddr(msg.PkgPath)
+	msgCtx := gnostdlibs.ExecContext{
+		ChainID:         gnoCtx.ChainID(),
+		ChainDomain:     params.ChainDomain,
+		Height:          gnoCtx.BlockHeight(),
+		Timestamp:       gnoCtx.BlockTime().Unix(),
+		OriginCaller:    msg.Caller.Bech32(),
+		OriginSend:      msg.Send,
+		OriginSendSpent: new(std.Coins),
+		Banker:          vm.NewSDKBanker(k.VMKeeper, gnoCtx),
+		Params:          vm.NewSDKParams(k.vmParams, gnoCtx),
+		EventLogger:     gnoCtx.EventLogger(),
+	}
+	// Construct machine and evaluate.
+	m := gno.NewMachineWithOptions(
+		gno.MachineOptions{
+			PkgPath:  "",
+			Output:   k.VMKeeper.Output,
+			Store:    gnostore,
+			Context:  msgCtx,
+			Alloc:    gnostore.GetAllocator(),
+			GasMeter: gnoCtx.GasMeter(),
+		})
+	defer m.Release()
+
+	// Decode the arguments, which are set as the constants of the call.
+	args, err := decodeJSONArgs(m.Alloc, gnostore, msg.Func, ft, jsonArgs)
+	if err != nil {
+		return "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	cx := &gno.CallExpr{
+		Func: m.MustParseExpr("pkg." + msg.Func),
+		Args: []gno.Expr{m.MustParseExpr("cross")},
+	}
+	for _, arg := range args {
+		cx.Args = append(cx.Args, &gno.ConstExpr{TypedValue: arg})
+	}
+
+	// Send send-coins to pkg from caller.
+	if err := k.vmBank().SendCoins(gnoCtx, msg.Caller, pkgAddr, msg.Send); err != nil {
+		return "", err
+	}
+
+	m.SetActivePackage(mpv)
+	defer doRecover(m, &err)
+	rtvs := m.Eval(cx)
+	for i, rtv := range rtvs {
+		res += rtv.String()
+		if i < len(rtvs)-1 {
+			res += "\n"
+		}
+	}
+
+	if err := processStorageDeposit(k.VMKeeper, gnoCtx, msg.Caller, msg.MaxDeposit, gnostore, params); err != nil {
+		return "", err
+	}
+
+	// use `\n\n` as separator to separate results for single tx with multi msgs
+	return res + "\n\n", nil
+}
+
+// crossingFuncType returns the type of the crossing function of the package.
+func crossingFuncType(store gno.Store, pn *gno.PackageNode, name string) (*gno.FuncType, error) {
+	if _, ok := pn.GetLocalIndex(gno.Name(name)); !ok {
+		return nil, fmt.Errorf("function %s not found in %s", name, pn.PkgPath)
+	}
+	ft, ok := pn.GetStaticTypeOf(store, gno.Name(name)).(*gno.FuncType)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a function of %s", name, pn.PkgPath)
+	}
+	if len(ft.Params) == 0 || ft.Params[0].Type.String() != ".uverse.realm" {
+		return nil, fmt.Errorf("function %s is non-crossing and cannot be called with MsgCall", name)
+	}
+	if ft.HasVarg() {
+		return nil, fmt.Errorf("variadic function %s cannot be called with json_args", name)
+	}
+
+	return ft, nil
+}
+
+// decodeJSONArgs decodes the JSON array of the arguments of the crossing
+// function, without its realm parameter.
+func decodeJSONArgs(alloc *gno.Allocator, store gno.Store, name string, ft *gno.FuncType, jsonArgs string) ([]gno.TypedValue, error) {
+	var values []json.RawMessage
+	if err := json.Unmarshal([]byte(jsonArgs), &values); err != nil {
+		return nil, fmt.Errorf("invalid JSON arguments in call to %s, expected a JSON array: %w", name, err)
+	}
+	params := ft.Params[1:]
+	if len(values) != len(params) {
+		return nil, fmt.Errorf("wrong number of arguments in call to %s: want %d got %d", name, len(params), len(values))
+	}
+
+	d := jsonDecoder{alloc: alloc, store: store}
+	args := make([]gno.TypedValue, len(values))
+	for i, v := range values {
+		arg, err := d.decode(params[i].Type, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument #%d (%s) in call to %s: %w", i+1, params[i].Name, name, err)
+		}
+		args[i] = arg
+	}
+
+	return args, nil
+}
+
+// jsonDecoder decodes JSON values to gno values of a given type, allocated
+// by the machine of the call.
+type jsonDecoder struct {
+	alloc *gno.Allocator
+	store gno.Store
+}
+
+// decode decodes the JSON value of type t. Integers are accepted as JSON
+// numbers or strings, bytes as base64 strings, and coins in the format of the
+// SDK (e.g. "10ugnot") as well as JSON objects.
+func (d jsonDecoder) decode(t gno.Type, v json.RawMessage) (gno.TypedValue, error) {
+	if dt, ok := t.(*gno.DeclaredType); ok {
+		switch dt.TypeID() {
+		case addressType:
+			return d.decodeAddress(t, v)
+		case coinType, coinsType:
+			v, err := sdkCoinsToJSON(dt, v)
+			if err != nil {
+				return gno.TypedValue{}, err
+			}
+			tv, err := d.decode(dt.Base, v)
+			tv.T = t
+			return tv, err
+		}
+	}
+
+	switch bt := gno.BaseOf(t).(type) {
+	case gno.PrimitiveType:
+		return decodePrimitive(t, bt, v)
+	case *gno.PointerType:
+		if isNull(v) {
+			return gno.TypedValue{T: t}, nil
+		}
+		elem, err := d.decode(bt.Elt, v)
+		if err != nil {
+			return gno.TypedValue{}, err
+		}
+		hv := d.alloc.NewHeapItem(elem)
+		return gno.TypedValue{T: t, V: gno.PointerValue{TV: &hv.Value, Base: hv, Index: 0}}, nil
+	case *gno.SliceType:
+		return d.decodeSlice(t, bt, v)
+	case *gno.ArrayType:
+		return d.decodeArray(t, bt, v)
+	case *gno.StructType:
+		return d.decodeStruct(t, bt, v)
+	case *gno.MapType:
+		return d.decodeMap(t, bt, v)
+	default:
+		return gno.TypedValue{}, fmt.Errorf("unsupported type %s", t.String())
+	}
+}
+
+func (d jsonDecoder) decodeAddress(t gno.Type, v json.RawMessage) (gno.TypedValue, error) {
+	var s string
+	if err := json.Unmarshal(v, &s); err != nil {
+		return gno.TypedValue{}, fmt.Errorf("expected a bech32 address, got %s", v)
+	}
+	if _, err := sdk.AccAddressFromBech32(s); err != nil {
+		return gno.TypedValue{}, fmt.Errorf("expected a bech32 address, got %q", s)
+	}
+
+	tv := gno.TypedValue{T: t}
+	tv.SetString(gno.StringValue(s))
+	return tv, nil
+}
+
+func (d jsonDecoder) decodeSlice(t gno.Type, st *gno.SliceType, v json.RawMessage) (gno.TypedValue, error) {
+	if isNull(v) {
+		return gno.TypedValue{T: t}, nil
+	}
+	if st.Elt.Kind() == gno.Uint8Kind {
+		if data, ok, err := decodeBytes(v); ok {
+			if err != nil {
+				return gno.TypedValue{}, err
+			}
+			return gno.TypedValue{T: t, V: d.alloc.NewSliceFromData(data)}, nil
+		}
+	}
+
+	list, err := d.decodeList(st.Elt, v)
+	if err != nil {
+		return gno.TypedValue{}, err
+	}
+	return gno.TypedValue{T: t, V: d.alloc.NewSliceFromList(list)}, nil
+}
+
+func (d jsonDecoder) decodeArray(t gno.Type, at *gno.ArrayType, v json.RawMessage) (gno.TypedValue, error) {
+	if at.Elt.Kind() == gno.Uint8Kind {
+		if data, ok, err := decodeBytes(v); ok {
+			if err != nil {
+				return gno.TypedValue{}, err
+			}
+			if len(data) != at.Len {
+				return gno.TypedValue{}, fmt.Errorf("expected %d bytes, got %d", at.Len, len(data))
+			}
+			av := d.alloc.NewDataArray(at.Len)
+			copy(av.Data, data)
+			return gno.TypedValue{T: t, V: av}, nil
+		}
+	}
+
+	list, err := d.decodeList(at.Elt, v)
+	if err != nil {
+		return gno.TypedValue{}, err
+	}
+	if len(list) != at.Len {
+		return gno.TypedValue{}, fmt.Errorf("expected %d elements, got %d", at.Len, len(list))
+	}
+	av := d.alloc.NewListArray(at.Len)
+	copy(av.List, list)
+	return gno.TypedValue{T: t, V: av}, nil
+}
+
+func (d jsonDecoder) decodeList(elt gno.Type, v json.RawMessage) ([]gno.TypedValue, error) {
+	var values []json.RawMessage
+	if err := json.Unmarshal(v, &values); err != nil {
+		return nil, fmt.Errorf("expected a JSON array, got %s", v)
+	}
+
+	list := make([]gno.TypedValue, len(values))
+	for i, ev := range values {
+		tv, err := d.decode(elt, ev)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		list[i] = tv
+	}
+	return list, nil
+}
+
+func (d jsonDecoder) decodeStruct(t gno.Type, st *gno.StructType, v json.RawMessage) (gno.TypedValue, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(v, &obj); err != nil || obj == nil {
+		return gno.TypedValue{}, fmt.Errorf("expected a JSON object, got %s", v)
+	}
+	for _, name := range slices.Sorted(maps.Keys(obj)) {
+		if !slices.ContainsFunc(st.Fields, func(f gno.FieldType) bool { return string(f.Name) == name }) {
+			return gno.TypedValue{}, fmt.Errorf("unknown field %s", name)
+		}
+	}
+
+	fields := d.alloc.NewStructFields(len(st.Fields))
+	for i, f := range st.Fields {
+		fv, ok := obj[string(f.Name)]
+		if !ok {
+			fields[i] = d.zero(f.Type)
+			continue
+		}
+		tv, err := d.decode(f.Type, fv)
+		if err != nil {
+			return gno.TypedValue{}, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		fields[i] = tv
+	}
+	return gno.TypedValue{T: t, V: d.alloc.NewStruct(fields)}, nil
+}
+
+func (d jsonDecoder) decodeMap(t gno.Type, mt *gno.MapType, v json.RawMessage) (gno.TypedValue, error) {
+	if isNull(v) {
+		return gno.TypedValue{T: t}, nil
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(v, &obj); err != nil {
+		return gno.TypedValue{}, fmt.Errorf("expected a JSON object, got %s", v)
+	}
+
+	// the keys are sorted, as gno maps are ordered by insertion
+	mv := d.alloc.NewMap(len(obj))
+	for _, key := range slices.Sorted(maps.Keys(obj)) {
+		kv, _ := json.Marshal(key)
+		ktv, err := d.decode(mt.Key, kv)
+		if err != nil {
+			return gno.TypedValue{}, fmt.Errorf("key %q: %w", key, err)
+		}
+		vtv, err := d.decode(mt.Value, obj[key])
+		if err != nil {
+			return gno.TypedValue{}, fmt.Errorf("key %q: %w", key, err)
+		}
+		ptr := mv.GetPointerForKey(d.alloc, d.store, ktv)
+		*ptr.TV = vtv
+	}
+	return gno.TypedValue{T: t, V: mv}, nil
+}
+
+// zero returns the zero value of type t, for the fields missing from the JSON
+// objects.
+func (d jsonDecoder) zero(t gno.Type) gno.TypedValue {
+	switch bt := gno.BaseOf(t).(type) {
+	case *gno.InterfaceType:
+		return gno.TypedValue{}
+	case *gno.StructType:
+		fields := d.alloc.NewStructFields(len(bt.Fields))
+		for i, f := range bt.Fields {
+			fields[i] = d.zero(f.Type)
+		}
+		return gno.TypedValue{T: t, V: d.alloc.NewStruct(fields)}
+	case *gno.ArrayType:
+		if bt.Elt.Kind() == gno.Uint8Kind {
+			return gno.TypedValue{T: t, V: d.alloc.NewDataArray(bt.Len)}
+		}
+		av := d.alloc.NewListArray(bt.Len)
+		for i := range av.List {
+			av.List[i] = d.zero(bt.Elt)
+		}
+		return gno.TypedValue{T: t, V: av}
+	default:
+		return gno.TypedValue{T: t}
+	}
+}
+
+// decodePrimitive decodes the JSON value of type t, of primitive type pt.
+func decodePrimitive(t gno.Type, pt gno.PrimitiveType, v json.RawMessage) (tv gno.TypedValue, err error) {
+	tv.T = t
+	switch pt {
+	case gno.BoolType:
+		var b bool
+		if err := json.Unmarshal(v, &b); err != nil {
+			return tv, fmt.Errorf("expected a bool, got %s", v)
+		}
+		tv.SetBool(b)
+	case gno.StringType:
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			return tv, fmt.Errorf("expected a string, got %s", v)
+		}
+		tv.SetString(gno.StringValue(s))
+	case gno.IntType, gno.Int8Type, gno.Int16Type, gno.Int32Type, gno.Int64Type:
+		n, err := strconv.ParseInt(numberText(v), 10, bitSize(pt))
+		if err != nil {
+			return tv, fmt.Errorf("expected %s, got %s", pt.String(), v)
+		}
+		switch pt {
+		case gno.IntType:
+			tv.SetInt(n)
+		case gno.Int8Type:
+			tv.SetInt8(int8(n))
+		case gno.Int16Type:
+			tv.SetInt16(int16(n))
+		case gno.Int32Type:
+			tv.SetInt32(int32(n))
+		default:
+			tv.SetInt64(n)
+		}
+	case gno.UintType, gno.Uint8Type, gno.Uint16Type, gno.Uint32Type, gno.Uint64Type:
+		n, err := strconv.ParseUint(numberText(v), 10, bitSize(pt))
+		if err != nil {
+			return tv, fmt.Errorf("expected %s, got %s", pt.String(), v)
+		}
+		switch pt {
+		case gno.UintType:
+			tv.SetUint(n)
+		case gno.Uint8Type:
+			tv.SetUint8(uint8(n))
+		case gno.Uint16Type:
+			tv.SetUint16(uint16(n))
+		case gno.Uint32Type:
+			tv.SetUint32(uint32(n))
+		default:
+			tv.SetUint64(n)
+		}
+	case gno.Float32Type:
+		f, err := strconv.ParseFloat(numberText(v), 32)
+		if err != nil {
+			return tv, fmt.Errorf("expected float32, got %s", v)
+		}
+		tv.SetFloat32(math.Float32bits(float32(f)))
+	case gno.Float64Type:
+		f, err := strconv.ParseFloat(numberText(v), 64)
+		if err != nil {
+			return tv, fmt.Errorf("expected float64, got %s", v)
+		}
+		tv.SetFloat64(math.Float64bits(f))
+	default:
+		return tv, fmt.Errorf("unsupported type %s", t.String())
+	}
+	return tv, nil
+}
+
+// bitSize returns the size of the integer types, int and uint being 64-bit
+// in gno.
+func bitSize(pt gno.PrimitiveType) int {
+	switch pt {
+	case gno.Int8Type, gno.Uint8Type:
+		return 8
+	case gno.Int16Type, gno.Uint16Type:
+		return 16
+	case gno.Int32Type, gno.Uint32Type:
+		return 32
+	default:
+		return 64
+	}
+}
+
+// numberText returns the text of a JSON number, or of a number given as a
+// JSON string.
+func numberText(v json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+	return string(v)
+}
+
+// decodeBytes decodes bytes given as a base64 JSON string. It returns false
+// when the value is not a JSON string.
+func decodeBytes(v json.RawMessage) ([]byte, bool, error) {
+	var s string
+	if err := json.Unmarshal(v, &s); err != nil {
+		return nil, false, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, true, fmt.Errorf("expected base64 encoded bytes, got %q", s)
+	}
+	return data, true, nil
+}
+
+func isNull(v json.RawMessage) bool {
+	return string(v) == "null"
+}
+
+// The type IDs of the declared types decoded from strings.
+var (
+	addressType = gno.DeclaredTypeID(".uverse", gno.Location{}, "address")
+	coinType    = gno.DeclaredTypeID("chain", gno.Location{}, "Coin")
+	coinsType   = gno.DeclaredTypeID("chain", gno.Location{}, "Coins")
+)
+
+// sdkCoinsToJSON converts coins given in the format of the SDK (e.g.
+// "10ugnot,5stake") to the JSON of the chain coins. Other values are
+// returned as is.
+func sdkCoinsToJSON(dt *gno.DeclaredType, v json.RawMessage) (json.RawMessage, error) {
+	var s string
+	if err := json.Unmarshal(v, &s); err != nil {
+		return v, nil
+	}
+
+	type jsonCoin struct {
+		Denom  string
+		Amount int64
+	}
+	toJSON := func(coin sdk.Coin) (jsonCoin, error) {
+		if !coin.Amount.IsInt64() {
+			return jsonCoin{}, fmt.Errorf("coin amount %s overflows int64", coin.Amount)
+		}
+		return jsonCoin{Denom: coin.Denom, Amount: coin.Amount.Int64()}, nil
+	}
+
+	if dt.TypeID() == coinType {
+		coin, err := sdk.ParseCoinNormalized(s)
+		if err != nil {
+			return nil, fmt.Errorf("expected a coin, got %q", s)
+		}
+		c, err := toJSON(coin)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(c)
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(s)
+	if err != nil {
+		return nil, fmt.Errorf("expected coins, got %q", s)
+	}
+	list := make([]jsonCoin, len(coins))
+	for i, coin := range coins {
+		if list[i], err = toJSON(coin); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(list)
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONDecoder_Decode(t *testing.T) {
+	d := jsonDecoder{}
+	item := &gno.StructType{
+		PkgPath: "gno.land/r/demo/orders",
+		Fields: []gno.FieldType{
+			{Name: "Name", Type: gno.StringType},
+			{Name: "Quantity", Type: gno.IntType},
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		typ      gno.Type
+		value    string
+		expected string
+		err      string
+	}{
+		{
+			desc:     "integer as number",
+			typ:      gno.Int64Type,
+			value:    `-42`,
+			expected: "(-42 int64)",
+		},
+		{
+			desc:     "integer as string",
+			typ:      gno.Uint64Type,
+			value:    `"18446744073709551615"`,
+			expected: "(18446744073709551615 uint64)",
+		},
+		{
+			desc:  "integer overflow",
+			typ:   gno.Uint8Type,
+			value: `256`,
+			err:   "expected uint8, got 256",
+		},
+		{
+			desc:  "fractional integer",
+			typ:   gno.IntType,
+			value: `1.5`,
+			err:   "expected int, got 1.5",
+		},
+		{
+			desc:     "float",
+			typ:      gno.Float64Type,
+			value:    `0.5`,
+			expected: "(0.5 float64)",
+		},
+		{
+			desc:  "bool",
+			typ:   gno.BoolType,
+			value: `"true"`,
+			err:   `expected a bool, got "true"`,
+		},
+		{
+			desc:  "bytes",
+			typ:   &gno.SliceType{Elt: gno.Uint8Type},
+			value: `"aGVsbG8="`,
+		},
+		{
+			desc:  "invalid bytes",
+			typ:   &gno.SliceType{Elt: gno.Uint8Type},
+			value: `"hello!"`,
+			err:   `expected base64 encoded bytes, got "hello!"`,
+		},
+		{
+			desc:  "array length",
+			typ:   &gno.ArrayType{Len: 2, Elt: gno.StringType},
+			value: `["a"]`,
+			err:   "expected 2 elements, got 1",
+		},
+		{
+			desc:  "pointer",
+			typ:   &gno.PointerType{Elt: item},
+			value: `{"Name": "apple"}`,
+		},
+		{
+			desc:  "slice element",
+			typ:   &gno.SliceType{Elt: item},
+			value: `[{"Name": "apple"}, {"Quantity": "two"}]`,
+			err:   `index 1: field Quantity: expected int, got "two"`,
+		},
+		{
+			desc:  "map key",
+			typ:   &gno.MapType{Key: gno.IntType, Value: gno.StringType},
+			value: `{"one": "a"}`,
+			err:   `key "one": expected int, got "one"`,
+		},
+		{
+			desc:  "unsupported type",
+			typ:   &gno.InterfaceType{},
+			value: `1`,
+			err:   "unsupported type interface {}",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			tv, err := d.decode(tc.typ, json.RawMessage(tc.value))
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.typ, tv.T)
+			if tc.expected != "" {
+				require.Equal(t, tc.expected, tv.String())
+			}
+		})
+	}
+}
+
+func TestJSONDecoder_DecodeMap(t *testing.T) {
+	d := jsonDecoder{}
+	mt := &gno.MapType{Key: gno.StringType, Value: gno.IntType}
+
+	tv, err := d.decode(mt, json.RawMessage(`{"b": 2, "a": "1", "c": 3}`))
+	require.NoError(t, err)
+
+	// the keys are inserted in order, as the order of gno maps is observable
+	var keys []string
+	for item := tv.V.(*gno.MapValue).List.Head; item != nil; item = item.Next {
+		keys = append(keys, item.Key.GetString())
+	}
+	require.Equal(t, []string{"a", "b", "c"}, keys)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// checkDeployer checks that the creator is allowed to add packages by the
+// deployment policy. The module authority is always allowed.
+func (k *Keeper) checkDeployer(ctx context.Context, creator string) error {
+	creatorBytes, err := k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid creator address")
+	}
+	if bytes.Equal(creatorBytes, k.authority) {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch params.DeploymentPolicy {
+	case types.DeploymentPolicy_DEPLOYMENT_POLICY_OPEN:
+		return nil
+	case types.DeploymentPolicy_DEPLOYMENT_POLICY_ALLOWLIST:
+		if slices.Contains(params.DeploymentAllowlist, creator) {
+			return nil
+		}
+		return errorsmod.Wrapf(types.ErrUnauthorizedDeployer, "%s is not in the deployment allowlist", creator)
+	default:
+		return errorsmod.Wrap(types.ErrUnauthorizedDeployer, "packages can only be added by governance")
+	}
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	goerrors "errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	tm2errors "github.com/gnolang/gno/tm2/pkg/errors"
+	gnostore "github.com/gnolang/gno/tm2/pkg/store"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// wrapVMError wraps an error returned by the VM with the module error of its
+// category, so that it has a proper ABCI code.
+func wrapVMError(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+
+	// errors which already have a code, e.g. from the bank keeper
+	var sdkErr *errorsmod.Error
+	if goerrors.As(err, &sdkErr) {
+		return errorsmod.Wrap(err, msg)
+	}
+
+	var (
+		typeCheckErr vm.TypeCheckError
+		tm2Err       tm2errors.Error
+	)
+	switch {
+	case goerrors.As(err, &typeCheckErr):
+		return errorsmod.Wrapf(types.ErrTypeCheck, "%s: %v", msg, err)
+	case goerrors.As(err, &vm.PkgExistError{}):
+		return errorsmod.Wrapf(types.ErrPackageExists, "%s: %v", msg, err)
+	case goerrors.As(err, &vm.UnauthorizedUserError{}):
+		return errorsmod.Wrapf(types.ErrUnauthorizedNamespace, "%s: %v", msg, err)
+	case goerrors.As(err, &vm.InvalidPackageError{}),
+		goerrors.As(err, &vm.InvalidPkgPathError{}),
+		goerrors.As(err, &vm.InvalidFileError{}):
+		return errorsmod.Wrapf(types.ErrInvalidPackage, "%s: %v", msg, err)
+	case goerrors.As(err, &vm.InvalidStmtError{}),
+		goerrors.As(err, &vm.InvalidExprError{}):
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s: %v", msg, err)
+	case strings.Contains(err.Error(), "not enough deposit"):
+		// the storage deposit errors of the VM have no type
+		return errorsmod.Wrapf(types.ErrInsufficientDeposit, "%s: %v", msg, err)
+	case goerrors.As(err, &tm2Err) && !isABCIError(tm2Err.Data()):
+		// the panics recovered by the VM are the only errors without data
+		if stacktrace := realmStacktrace(tm2Err); stacktrace != "" {
+			return errorsmod.Wrapf(types.ErrRealmPanic, "%s: %v\n%s", msg, err, stacktrace)
+		}
+		return errorsmod.Wrapf(types.ErrRealmPanic, "%s: %v", msg, err)
+	default:
+		return errorsmod.Wrap(err, msg)
+	}
+}
+
+// maxStacktraceLen bounds the size of the stacktraces included in the logs of
+// the transactions.
+const maxStacktraceLen = 4096
+
+// emitRealmPanicEvent emits the stacktrace of a panic recovered by the VM, if
+// the error is one.
+func emitRealmPanicEvent(ctx sdk.Context, err error) {
+	var tm2Err tm2errors.Error
+	if !goerrors.As(err, &tm2Err) || isABCIError(tm2Err.Data()) {
+		return
+	}
+	stacktrace := realmStacktrace(tm2Err)
+	if stacktrace == "" {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRealmPanic,
+		sdk.NewAttribute(types.AttributeKeyStacktrace, stacktrace),
+	))
+}
+
+// realmStacktrace returns the gno stacktrace of a panic recovered by the VM,
+// with the location and the function of each frame, e.g.
+//
+//	panic: boom
+//	gno.land/r/demo/boom/boom.gno:4 pkg.Boom
+//
+// The VM only keeps it in the message traces of the error, which are not part
+// of its message.
+func realmStacktrace(err tm2errors.Error) string {
+	const header, footer = "\nStacktrace:\n", "--= /Error =--"
+
+	trace := fmt.Sprintf("%+v", err)
+	_, stacktrace, found := strings.Cut(trace, header)
+	if !found {
+		return ""
+	}
+	stacktrace, _, _ = strings.Cut(stacktrace, footer)
+	stacktrace = formatStacktrace(strings.TrimSpace(stacktrace))
+
+	return truncateStacktrace(stacktrace, maxStacktraceLen)
+}
+
+// valuePathRe matches the value paths of the names printed by the VM, e.g.
+// the <VPBlock(1,0)> of pkg<VPBlock(1,0)>.
+var valuePathRe = regexp.MustCompile(`<[()!~]*VP[A-Za-z]+\([^)]*\)>`)
+
+// formatStacktrace formats the frames of a stacktrace printed by the VM, made
+// of the call expression of the frame followed by its indented location, e.g.
+//
+//	pkg<VPBlock(1,0)>.Boom(undefined)
+//	    gno.land/r/demo/boom/boom.gno:4
+//
+// as pkgpath/file.gno:line func lines. The other lines, e.g. the panics, are
+// kept as is.
+func formatStacktrace(stacktrace string) string {
+	const indent = "    "
+
+	lines := strings.Split(stacktrace, "\n")
+	frames := make([]string, 0, len(lines))
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if strings.HasPrefix(line, indent) || i+1 == len(lines) || !strings.HasPrefix(lines[i+1], indent) {
+			frames = append(frames, line)
+			continue
+		}
+
+		fn, deferred := strings.CutPrefix(line, "defer ")
+		fn = callee(valuePathRe.ReplaceAllString(fn, ""))
+		if deferred {
+			fn += " (deferred)"
+		}
+		location := strings.TrimSpace(lines[i+1])
+		// the natives have no line
+		if native, ok := strings.CutPrefix(location, "gonative:"); ok {
+			location = native + " (native)"
+		}
+		frames = append(frames, location+" "+fn)
+		i++
+	}
+
+	return strings.Join(frames, "\n")
+}
+
+// callee returns the function of a call expression, without its arguments.
+func callee(call string) string {
+	if !strings.HasSuffix(call, ")") {
+		return call
+	}
+
+	depth := 0
+	for i := len(call) - 1; i >= 0; i-- {
+		switch call[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+			if depth == 0 {
+				return call[:i]
+			}
+		}
+	}
+	return call
+}
+
+// truncateStacktrace truncates a stacktrace to its first lines fitting in
+// size, keeping the innermost frames which are printed first.
+func truncateStacktrace(stacktrace string, size int) string {
+	if len(stacktrace) <= size {
+		return stacktrace
+	}
+
+	const elided = "\n..."
+	stacktrace = stacktrace[:size-len(elided)]
+	if i := strings.LastIndexByte(stacktrace, '\n'); i >= 0 {
+		stacktrace = stacktrace[:i]
+	}
+	return stacktrace + elided
+}
+
+// isABCIError returns whether the data of a VM error is one of its ABCI errors.
+func isABCIError(data any) bool {
+	_, ok := data.(interface{ AssertABCIError() })
+	return ok
+}
+
+// recoverVMPanic returns the error of a panic escaping the VM.
+func recoverVMPanic(r any) error {
+	switch rType := r.(type) {
+	case gnostore.OutOfGasError:
+		return errorsmod.Wrap(sdkerrors.ErrOutOfGas, rType.Descriptor)
+	case storetypes.ErrorOutOfGas:
+		return errorsmod.Wrap(sdkerrors.ErrOutOfGas, rType.Descriptor)
+	default:
+		return errorsmod.Wrapf(types.ErrRealmPanic, "panic while calling VM: %v (%v)", r, rType)
+	}
+}
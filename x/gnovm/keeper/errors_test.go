@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatStacktrace(t *testing.T) {
+	tests := []struct {
+		name       string
+		stacktrace string
+		expected   string
+	}{
+		{
+			name:       "frames",
+			stacktrace: "panic: boom\npkg<VPBlock(1,0)>.Boom(undefined)\n    gno.land/r/demo/boom/boom.gno:4\nmain<VPBlock(1,1)>(f<!~VPBlock(1,0)>(1), \"a\")\n    gno.land/r/demo/boom/main.gno:12",
+			expected:   "panic: boom\ngno.land/r/demo/boom/boom.gno:4 pkg.Boom\ngno.land/r/demo/boom/main.gno:12 main",
+		},
+		{
+			name:       "deferred frame",
+			stacktrace: "defer func(){ ... }()\n    gno.land/r/demo/boom/boom.gno:8",
+			expected:   "gno.land/r/demo/boom/boom.gno:8 func(){ ... } (deferred)",
+		},
+		{
+			name:       "native frame",
+			stacktrace: "strconv<VPBlock(2,0)>.Itoa(1)\n    gonative:strconv/strconv.gno",
+			expected:   "strconv/strconv.gno (native) strconv.Itoa",
+		},
+		{
+			name:       "elided frames",
+			stacktrace: "f<VPBlock(1,0)>()\n    gno.land/r/demo/f/f.gno:1\n...2 frame(s) elided...",
+			expected:   "gno.land/r/demo/f/f.gno:1 f\n...2 frame(s) elided...",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, formatStacktrace(tc.stacktrace))
+		})
+	}
+}
+
+func TestTruncateStacktrace(t *testing.T) {
+	stacktrace := "panic: boom\nBoom<VPBlock(3,0)>()\n    gno.land/r/demo/boom/boom.gno:4"
+	require.Equal(t, stacktrace, truncateStacktrace(stacktrace, len(stacktrace)))
+
+	// the innermost frames are kept, up to a full line
+	require.Equal(t, "panic: boom\nBoom<VPBlock(3,0)>()\n...", truncateStacktrace(stacktrace, 40))
+
+	long := strings.Repeat("f<VPBlock(1,0)>()\n    gno.land/r/demo/f/f.gno:1\n", 1000)
+	require.LessOrEqual(t, len(truncateStacktrace(long, maxStacktraceLen)), maxStacktraceLen)
+}
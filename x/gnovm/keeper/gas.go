@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"math"
+
+	storetypes "cosmossdk.io/store/types"
+	gnostore "github.com/gnolang/gno/tm2/pkg/store"
+)
+
+var _ gnostore.GasMeter = (*vmGasMeter)(nil)
+
+// vmGasMeter is a wrapper of the Cosmos SDK gas meter to the VM expected gas meter.
+// All gas consumed by the VM (CPU cycles, allocations and gno store access) is
+// charged on the SDK gas meter, so it counts against the transaction gas limit.
+type vmGasMeter struct {
+	meter storetypes.GasMeter
+}
+
+// newVMGasMeter returns a VM gas meter consuming gas on the given SDK gas meter.
+func newVMGasMeter(meter storetypes.GasMeter) *vmGasMeter {
+	return &vmGasMeter{meter: meter}
+}
+
+// GasConsumed implements gnostore.GasMeter.
+func (g *vmGasMeter) GasConsumed() gnostore.Gas {
+	return toGnoGas(g.meter.GasConsumed())
+}
+
+// GasConsumedToLimit implements gnostore.GasMeter.
+func (g *vmGasMeter) GasConsumedToLimit() gnostore.Gas {
+	return toGnoGas(g.meter.GasConsumedToLimit())
+}
+
+// Limit implements gnostore.GasMeter.
+func (g *vmGasMeter) Limit() gnostore.Gas {
+	return toGnoGas(g.meter.Limit())
+}
+
+// Remaining implements gnostore.GasMeter.
+func (g *vmGasMeter) Remaining() gnostore.Gas {
+	return toGnoGas(g.meter.GasRemaining())
+}
+
+// ConsumeGas implements gnostore.GasMeter.
+// An out of gas panic of the SDK gas meter is converted into the VM out of gas
+// error, so that the VM handles it the same way as its own gas meter.
+func (g *vmGasMeter) ConsumeGas(amount gnostore.Gas, descriptor string) {
+	if amount < 0 {
+		panic("gas must not be negative")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				panic(gnostore.OutOfGasError{Descriptor: oog.Descriptor})
+			}
+			panic(r)
+		}
+	}()
+
+	g.meter.ConsumeGas(storetypes.Gas(amount), descriptor)
+}
+
+// IsPastLimit implements gnostore.GasMeter.
+func (g *vmGasMeter) IsPastLimit() bool {
+	return g.meter.IsPastLimit()
+}
+
+// IsOutOfGas implements gnostore.GasMeter.
+func (g *vmGasMeter) IsOutOfGas() bool {
+	return g.meter.IsOutOfGas()
+}
+
+// toGnoGas converts an SDK gas amount to a VM gas amount, capping it to the
+// maximum value the VM can represent (e.g. for infinite gas meters).
+func toGnoGas(gas storetypes.Gas) gnostore.Gas {
+	if gas > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return gnostore.Gas(gas)
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"math"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	gnostore "github.com/gnolang/gno/tm2/pkg/store"
+)
+
+func TestVMGasMeter_ConsumeGas(t *testing.T) {
+	sdkMeter := storetypes.NewGasMeter(100)
+	meter := newVMGasMeter(sdkMeter)
+
+	meter.ConsumeGas(40, "test")
+	require.Equal(t, gnostore.Gas(40), meter.GasConsumed())
+	require.Equal(t, storetypes.Gas(40), sdkMeter.GasConsumed())
+	require.Equal(t, gnostore.Gas(60), meter.Remaining())
+	require.Equal(t, gnostore.Gas(100), meter.Limit())
+	require.False(t, meter.IsOutOfGas())
+
+	// consuming gas on the SDK meter is reflected on the VM meter
+	sdkMeter.ConsumeGas(10, "sdk")
+	require.Equal(t, gnostore.Gas(50), meter.GasConsumed())
+
+	require.PanicsWithValue(t, "gas must not be negative", func() {
+		meter.ConsumeGas(-1, "negative")
+	})
+}
+
+func TestVMGasMeter_OutOfGas(t *testing.T) {
+	sdkMeter := storetypes.NewGasMeter(100)
+	meter := newVMGasMeter(sdkMeter)
+
+	require.PanicsWithValue(t, gnostore.OutOfGasError{Descriptor: "loop"}, func() {
+		meter.ConsumeGas(101, "loop")
+	})
+	require.True(t, meter.IsPastLimit())
+	require.True(t, sdkMeter.IsPastLimit())
+	require.Equal(t, gnostore.Gas(100), meter.GasConsumedToLimit())
+}
+
+func TestVMGasMeter_InfiniteGasMeter(t *testing.T) {
+	meter := newVMGasMeter(storetypes.NewInfiniteGasMeter())
+
+	meter.ConsumeGas(math.MaxInt64, "max")
+	require.Equal(t, gnostore.Gas(math.MaxInt64), meter.GasConsumed())
+	require.Equal(t, gnostore.Gas(math.MaxInt64), meter.Limit())
+	require.Equal(t, gnostore.Gas(math.MaxInt64), meter.Remaining())
+	require.False(t, meter.IsOutOfGas())
+}
@@ -4,25 +4,32 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 
+	"cosmossdk.io/collections"
+
+	"github.com/ignite/gnovm/x/gnovm/stdlibs"
 	"github.com/ignite/gnovm/x/gnovm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
 	"github.com/gnolang/gno/tm2/pkg/sdk/params"
-
-	"golang.org/x/tools/go/packages"
 )
 
-const defaultStdLibs = "github.com/gnolang/gno/gnovm/stdlibs"
-
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k *Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
 	if err := k.Params.Set(ctx, genState.Params); err != nil {
 		return err
 	}
 
+	// Refuse to start the chain with different stdlibs than the genesis ones.
+	checksum := stdlibs.Checksum()
+	if genState.StdlibsChecksum != "" && genState.StdlibsChecksum != checksum {
+		return fmt.Errorf("stdlibs checksum mismatch: genesis expects %s, got %s", genState.StdlibsChecksum, checksum)
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// Import all key-value pairs into the store
@@ -35,6 +42,25 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) e
 		}
 	}
 
+	// The standard library is loaded from the embedded sources
+	stdlibsDir, err := os.MkdirTemp("", "gnovm-stdlibs-")
+	if err != nil {
+		return fmt.Errorf("failed to create gno stdlibs directory: %w", err)
+	}
+	defer os.RemoveAll(stdlibsDir)
+
+	if err := stdlibs.Extract(stdlibsDir); err != nil {
+		return fmt.Errorf("failed to extract gno stdlibs: %w", err)
+	}
+
+	// Rebuild the packages from their structured export, before the VM is
+	// initialized by BuildGnoContext.
+	if len(genState.Packages) > 0 {
+		if err := k.importPackages(sdkCtx, stdlibsDir, genState.Packages); err != nil {
+			return err
+		}
+	}
+
 	gnoCtx, err := k.BuildGnoContext(sdkCtx)
 	if err != nil {
 		return err
@@ -56,21 +82,103 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) e
 		},
 	)
 
-	pkg, err := packages.Load(&packages.Config{Mode: packages.LoadFiles}, defaultStdLibs)
-	if err != nil {
-		return fmt.Errorf("failed to load gno stdlib packages: %w", err)
+	// Initialize the standard library, unless loaded with the packages
+	if len(genState.Packages) == 0 {
+		k.VMKeeper.LoadStdlib(gnoCtx, stdlibsDir)
 	}
-	if len(pkg) == 0 {
-		return fmt.Errorf("no gno stdlib packages found")
+	k.commitGnoTransactionStore(gnoCtx)
+
+	// Record the checksum of the loaded stdlibs in state, so that it is part
+	// of the app hash and validators with different stdlibs cannot agree.
+	if err := k.StdlibsChecksum.Set(ctx, checksum); err != nil {
+		return err
 	}
 
-	// Initialize the standard library
-	k.VMKeeper.LoadStdlib(gnoCtx, pkg[0].Dir)
-	k.VMKeeper.CommitGnoTransactionStore(gnoCtx)
+	for _, ns := range genState.Namespaces {
+		if err := k.Namespaces.Set(ctx, ns.Name, ns); err != nil {
+			return err
+		}
+	}
+
+	for _, deposit := range genState.StorageDeposits {
+		if err := k.StorageDeposits.Set(ctx, collections.Join(deposit.PkgPath, deposit.Payer), deposit); err != nil {
+			return err
+		}
+	}
+
+	// Deploy the genesis packages, in order, on top of the stdlibs
+	for _, pkg := range genState.GenesisPackages {
+		if err := k.deployGenesisPackage(sdkCtx, pkg); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// deployGenesisPackage adds a genesis package to the VM and makes its init call, if any.
+func (k *Keeper) deployGenesisPackage(sdkCtx sdk.Context, pkg types.GenesisPackage) (err error) {
+	creatorBytes, err := k.addressCodec.StringToBytes(pkg.Creator)
+	if err != nil {
+		return fmt.Errorf("invalid genesis package creator %s: %w", pkg.Creator, err)
+	}
+	creator := types.ToCryptoAddress(creatorBytes)
+
+	mpkg, err := pkg.MemPackage()
+	if err != nil {
+		return err
+	}
+
+	gnoCtx, err := k.BuildGnoContext(sdkCtx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while deploying genesis package %s: %v", mpkg.Path, r)
+		}
+	}()
+
+	if err := k.VMKeeper.AddPackage(gnoCtx, vm.MsgAddPackage{
+		Creator: creator,
+		Package: mpkg,
+	}); err != nil {
+		return fmt.Errorf("failed to add genesis package %s: %w", mpkg.Path, err)
+	}
+
+	if pkg.InitCall != nil {
+		if _, err := k.VMKeeper.Call(gnoCtx, vm.MsgCall{
+			Caller:  creator,
+			PkgPath: mpkg.Path,
+			Func:    pkg.InitCall.Function,
+			Args:    pkg.InitCall.Args,
+		}); err != nil {
+			return fmt.Errorf("failed to call %s.%s of genesis package: %w", mpkg.Path, pkg.InitCall.Function, err)
+		}
+	}
+
+	k.commitGnoTransactionStore(gnoCtx)
+
+	if err := k.recordStorageDeposits(sdkCtx, pkg.Creator, gnoCtx.EventLogger().Events()); err != nil {
+		return fmt.Errorf("failed to record storage deposits of genesis package %s: %w", mpkg.Path, err)
+	}
+
+	// the namespaces of the genesis packages are not enforced, but claimed
+	// for their creator when unclaimed
+	name, owner, err := k.packageNamespace(sdkCtx, mpkg.Path)
+	if err != nil {
+		return err
+	}
+	if name != "" && owner == "" {
+		if err := k.setNamespaceOwner(sdkCtx, name, pkg.Creator); err != nil {
+			return err
+		}
+	}
+
+	return k.indexPackage(sdkCtx, mpkg.Path, pkg.Creator, pkg.Admin)
+}
+
 // ExportGenesis returns the module's exported genesis.
 func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -87,10 +195,47 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, fmt.Errorf("failed to marshal realm params: %w", err)
 	}
 
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get params: %w", err)
+	}
+
 	genesis := types.DefaultGenesis()
-	genesis.Params = types.VmParamsToParams(vmGenState.Params)
+	genesis.Params = params.WithVmParams(vmGenState.Params)
 	genesis.RealmParams = realmParams
 
+	stdlibsChecksum, err := k.StdlibsChecksum.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get stdlibs checksum: %w", err)
+	}
+	genesis.StdlibsChecksum = stdlibsChecksum
+
+	if k.exportFormat == types.ExportFormatPackages {
+		genesis.Packages, err = k.exportPackages(sdkCtx)
+		if err != nil {
+			return nil, err
+		}
+		genesis.PackagesVersion = types.PackagesVersion
+
+		err = k.Namespaces.Walk(ctx, nil, func(_ string, ns types.Namespace) (bool, error) {
+			genesis.Namespaces = append(genesis.Namespaces, ns)
+			return false, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to export namespaces: %w", err)
+		}
+
+		err = k.StorageDeposits.Walk(ctx, nil, func(_ collections.Pair[string, string], deposit types.StorageDeposit) (bool, error) {
+			genesis.StorageDeposits = append(genesis.StorageDeposits, deposit)
+			return false, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to export storage deposits: %w", err)
+		}
+
+		return genesis, nil
+	}
+
 	// Export all key-value pairs from the store
 	store := k.storeService.OpenKVStore(sdkCtx)
 	iterator, err := store.Iterator(nil, nil)
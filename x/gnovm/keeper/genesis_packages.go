@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	gnostdlibs "github.com/gnolang/gno/gnovm/stdlibs"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	gnosdk "github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	gnostore "github.com/gnolang/gno/tm2/pkg/store"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// The gno store persists each object under "oid:<object id>", prefixed by
+// the hash of its amino encoding, and the realm records under
+// "oid:<package id>#realm". Escaped objects have their hash in the iavl store.
+const (
+	objectKeyPrefix = "oid:"
+	realmKeySuffix  = "#realm"
+)
+
+// SetExportFormat sets the format of the VM state in the exported genesis.
+func (k *Keeper) SetExportFormat(format types.ExportFormat) {
+	k.exportFormat = format
+}
+
+// gnoStores returns the gno stores used by the VM, bound to the sdkCtx.
+func (k *Keeper) gnoStores(sdkCtx sdk.Context) (baseStore, iavlStore gnostore.Store) {
+	ms := NewGnovmMultiStore(
+		k.logger,
+		k.storeService,
+		k.memStoreService,
+		gnostore.NewStoreKey(k.storeKey.Name()),
+		gnostore.NewStoreKey(k.memStoreKey.Name()),
+	)
+
+	gnoCtx := gnosdk.NewContext(
+		gnosdk.RunTxModeDeliver,
+		ms,
+		k.gnoBlockHeader(sdkCtx),
+		types.NewSlogFromCosmosLogger(k.logger),
+	).WithContext(sdkCtx)
+
+	if lazyStore, ok := ms.(*gnovmMultiStore); ok {
+		lazyStore.SetContext(gnoCtx, sdkCtx)
+	}
+
+	return ms.GetStore(gnostore.NewStoreKey(k.storeKey.Name())), ms.GetStore(gnostore.NewStoreKey(k.memStoreKey.Name()))
+}
+
+// exportPackages exports the deployed packages, in deployment order, with
+// their realm record and objects. The stdlibs are not exported as they are
+// loaded from the embedded sources at genesis.
+func (k *Keeper) exportPackages(sdkCtx sdk.Context) ([]types.PackageState, error) {
+	baseStore, iavlStore := k.gnoStores(sdkCtx)
+	gs := gno.NewStore(nil, baseStore, iavlStore)
+
+	var (
+		pkgs []types.PackageState
+		err  error
+	)
+	seen := make(map[string]bool)
+	// the channel must be drained, so errors are only recorded
+	for mpkg := range gs.IterMemPackage() {
+		if err != nil || gno.IsStdlib(mpkg.Path) || seen[mpkg.Path] {
+			continue
+		}
+		seen[mpkg.Path] = true
+
+		var pkg types.PackageState
+		pkg, err = exportPackage(baseStore, gs, mpkg)
+		if err != nil {
+			err = fmt.Errorf("failed to export package %s: %w", mpkg.Path, err)
+			continue
+		}
+
+		// packages deployed before the index was introduced have no creator
+		if indexed, getErr := k.Packages.Get(sdkCtx, mpkg.Path); getErr == nil {
+			pkg.Creator = indexed.Creator
+			pkg.Admin = indexed.Admin
+		} else if !errors.Is(getErr, collections.ErrNotFound) {
+			err = fmt.Errorf("failed to get package %s: %w", mpkg.Path, getErr)
+			continue
+		}
+
+		pkgs = append(pkgs, pkg)
+	}
+
+	return pkgs, err
+}
+
+func exportPackage(baseStore gnostore.Store, gs gno.Store, mpkg *std.MemPackage) (types.PackageState, error) {
+	var (
+		pkg types.PackageState
+		err error
+	)
+
+	pkg.Package, err = json.Marshal(mpkg)
+	if err != nil {
+		return pkg, err
+	}
+
+	if rlm := gs.GetPackageRealm(mpkg.Path); rlm != nil {
+		pkg.Realm, err = amino.MarshalJSON(rlm)
+		if err != nil {
+			return pkg, err
+		}
+	}
+
+	prefix := []byte(objectKeyPrefix + hex.EncodeToString(gno.PkgIDFromPkgPath(mpkg.Path).Bytes()) + ":")
+	iter := baseStore.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if bytes.HasSuffix(iter.Key(), []byte(realmKeySuffix)) {
+			continue
+		}
+
+		var oo gno.Object
+		if err := amino.Unmarshal(iter.Value()[gno.HashSize:], &oo); err != nil {
+			return pkg, fmt.Errorf("failed to decode object %s: %w", iter.Key(), err)
+		}
+		bz, err := amino.MarshalJSONAny(oo)
+		if err != nil {
+			return pkg, fmt.Errorf("failed to encode object %s: %w", iter.Key(), err)
+		}
+		pkg.Objects = append(pkg.Objects, bz)
+	}
+
+	return pkg, nil
+}
+
+// importPackages rebuilds the VM store from the structured export of the
+// packages. The stdlibs are loaded first, as the packages depend on them.
+// It must be called before the VM is initialized, so that the VM preprocesses
+// the imported packages, rebuilding their types and nodes.
+func (k *Keeper) importPackages(sdkCtx sdk.Context, stdlibsDir string, pkgs []types.PackageState) error {
+	baseStore, iavlStore := k.gnoStores(sdkCtx)
+	gs := gno.NewStore(nil, baseStore, iavlStore)
+	gs.SetNativeResolver(gnostdlibs.NativeResolver)
+
+	for _, lib := range gnostdlibs.InitOrder() {
+		mpkg, err := gno.ReadMemPackage(filepath.Join(stdlibsDir, lib), lib, gno.MPStdlibAll)
+		if err != nil {
+			return fmt.Errorf("failed to read stdlib %s: %w", lib, err)
+		}
+
+		m := gno.NewMachineWithOptions(gno.MachineOptions{
+			PkgPath:     lib,
+			Store:       gs,
+			SkipPackage: true,
+		})
+		m.RunMemPackage(mpkg, true)
+		m.Release()
+	}
+
+	for _, pkg := range pkgs {
+		mpkg, err := pkg.MemPackage()
+		if err != nil {
+			return err
+		}
+		if err := importPackage(baseStore, iavlStore, gs, mpkg, pkg); err != nil {
+			return fmt.Errorf("failed to import package %s: %w", mpkg.Path, err)
+		}
+		if err := k.indexPackage(sdkCtx, mpkg.Path, pkg.Creator, pkg.Admin); err != nil {
+			return fmt.Errorf("failed to index package %s: %w", mpkg.Path, err)
+		}
+	}
+
+	return nil
+}
+
+func importPackage(baseStore, iavlStore gnostore.Store, gs gno.Store, mpkg *std.MemPackage, pkg types.PackageState) error {
+	// the package type is not preserved by the JSON encoding
+	mpkg.Type = gno.MPUserAll
+	gs.AddMemPackage(mpkg, gno.MPUserAll)
+
+	if len(pkg.Realm) > 0 {
+		var rlm *gno.Realm
+		if err := amino.UnmarshalJSON(pkg.Realm, &rlm); err != nil {
+			return fmt.Errorf("failed to decode realm: %w", err)
+		}
+		gs.SetPackageRealm(rlm)
+	}
+
+	// objects are encoded as the gno store does, with the amino codec of
+	// the running VM
+	for _, bz := range pkg.Objects {
+		var oo gno.Object
+		if err := amino.UnmarshalJSON(bz, &oo); err != nil {
+			return fmt.Errorf("failed to decode object: %w", err)
+		}
+
+		oid := oo.GetObjectID()
+		if oid.PkgID != gno.PkgIDFromPkgPath(mpkg.Path) {
+			return fmt.Errorf("object %s does not belong to the package", oid)
+		}
+
+		objBz, err := amino.MarshalAny(oo)
+		if err != nil {
+			return fmt.Errorf("failed to encode object %s: %w", oid, err)
+		}
+		hash := gno.HashBytes(objBz)
+		baseStore.Set([]byte(objectKeyPrefix+oid.String()), append(hash.Bytes(), objBz...))
+		if oo.GetIsEscaped() {
+			iavlStore.Set([]byte(oid.String()), hash.Bytes())
+		}
+	}
+
+	return nil
+}
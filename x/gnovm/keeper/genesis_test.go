@@ -1,9 +1,17 @@
 package keeper_test
 
 import (
+	"encoding/json"
+	"path/filepath"
 	"testing"
 
+	"cosmossdk.io/collections"
+	"github.com/golang/mock/gomock"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ignite/gnovm/x/gnovm/keeper"
+	"github.com/ignite/gnovm/x/gnovm/stdlibs"
 	"github.com/ignite/gnovm/x/gnovm/types"
 
 	"github.com/stretchr/testify/require"
@@ -23,6 +31,87 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.Params, got.Params)
 }
 
+func TestGenesisStdlibsChecksum(t *testing.T) {
+	f := initFixture(t)
+	err := f.keeper.InitGenesis(f.ctx, types.GenesisState{
+		Params:          types.DefaultParams(),
+		StdlibsChecksum: stdlibs.Checksum(),
+	})
+	require.NoError(t, err)
+
+	checksum, err := f.keeper.StdlibsChecksum.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, stdlibs.Checksum(), checksum)
+
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, stdlibs.Checksum(), got.StdlibsChecksum)
+}
+
+func TestGenesisStdlibsChecksumMismatch(t *testing.T) {
+	f := initFixture(t)
+	err := f.keeper.InitGenesis(f.ctx, types.GenesisState{
+		Params:          types.DefaultParams(),
+		StdlibsChecksum: "0000000000000000000000000000000000000000000000000000000000000000",
+	})
+	require.ErrorContains(t, err, "stdlibs checksum mismatch")
+}
+
+func TestGenesisPackages(t *testing.T) {
+	f := initFixture(t)
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	mpkg, err := ReadMemPackageFromDir(filepath.Join("testdata", "counter"))
+	require.NoError(t, err)
+
+	pkg, err := types.NewGenesisPackage(creatorStr, mpkg, &types.GenesisPackageCall{Function: "Increment"})
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	// storage deposits of the package deployment and of the init call
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	err = f.keeper.InitGenesis(f.ctx, types.GenesisState{
+		Params:          types.DefaultParams(),
+		GenesisPackages: []types.GenesisPackage{pkg},
+	})
+	require.NoError(t, err)
+
+	gnoCtx, err := f.keeper.BuildGnoContext(sdk.UnwrapSDKContext(f.ctx))
+	require.NoError(t, err)
+	res, err := f.keeper.VMKeeper.QueryEvalString(gnoCtx, mpkg.Path, `Render("")`)
+	require.NoError(t, err)
+	require.Equal(t, "1", res)
+}
+
+func TestGenesisPackages_InvalidInitCall(t *testing.T) {
+	f := initFixture(t)
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	mpkg, err := ReadMemPackageFromDir(filepath.Join("testdata", "counter"))
+	require.NoError(t, err)
+
+	pkg, err := types.NewGenesisPackage(creatorStr, mpkg, &types.GenesisPackageCall{Function: "Unknown"})
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	err = f.keeper.InitGenesis(f.ctx, types.GenesisState{
+		Params:          types.DefaultParams(),
+		GenesisPackages: []types.GenesisPackage{pkg},
+	})
+	require.ErrorContains(t, err, "gno.land/r/demo/counter")
+}
+
 func TestGenesisStateExport(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -230,3 +319,75 @@ func TestGenesisRoundTrip(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []byte("additional_value"), valAdditional)
 }
+
+func TestGenesisPackagesRoundTrip(t *testing.T) {
+	f := initFixture(t)
+	f.keeper.SetExportFormat(types.ExportFormatPackages)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	mpkg, err := ReadMemPackageFromDir(filepath.Join("testdata", "counter"))
+	require.NoError(t, err)
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, nil, pkgBz))
+	require.NoError(t, err)
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, nil, mpkg.Path, "Increment", nil))
+	require.NoError(t, err)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Empty(t, exported.State)
+	require.EqualValues(t, types.PackagesVersion, exported.PackagesVersion)
+	require.Len(t, exported.Packages, 1)
+	require.NotEmpty(t, exported.Packages[0].Realm)
+	require.NotEmpty(t, exported.Packages[0].Objects)
+	require.Equal(t, creatorStr, exported.Packages[0].Creator)
+	require.Equal(t, []types.Namespace{{Name: "demo", Owner: creatorStr}}, exported.Namespaces)
+	require.Equal(t, types.DefaultParams().ReservedNamespaces, exported.Params.ReservedNamespaces)
+	require.Len(t, exported.StorageDeposits, 1)
+	require.Equal(t, creatorStr, exported.StorageDeposits[0].Payer)
+	require.NoError(t, exported.Validate())
+
+	// rebuild the state from the structured export
+	f2 := initFixture(t)
+	ms2 := keeper.NewMsgServerImpl(&f2.keeper)
+	require.NoError(t, f2.keeper.InitGenesis(f2.ctx, *exported))
+
+	f2.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f2.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	gnoCtx, err := f2.keeper.BuildGnoContext(sdk.UnwrapSDKContext(f2.ctx))
+	require.NoError(t, err)
+	res, err := f2.keeper.VMKeeper.QueryEvalString(gnoCtx, mpkg.Path, `Render("")`)
+	require.NoError(t, err)
+	require.Equal(t, "1", res)
+
+	indexed, err := f2.keeper.Packages.Get(f2.ctx, mpkg.Path)
+	require.NoError(t, err)
+	require.Equal(t, creatorStr, indexed.Creator)
+
+	ns, err := f2.keeper.Namespaces.Get(f2.ctx, "demo")
+	require.NoError(t, err)
+	require.Equal(t, creatorStr, ns.Owner)
+
+	deposit, err := f2.keeper.StorageDeposits.Get(f2.ctx, collections.Join(mpkg.Path, creatorStr))
+	require.NoError(t, err)
+	require.Equal(t, exported.StorageDeposits[0], deposit)
+
+	// the realm is still usable after the import
+	resp, err := ms2.Call(f2.ctx, types.NewMsgCall(creatorStr, nil, nil, mpkg.Path, "Increment", nil))
+	require.NoError(t, err)
+	require.Contains(t, resp.Result, "2")
+}
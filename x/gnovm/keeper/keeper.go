@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sync"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -29,8 +30,11 @@ func init() {
 
 type Keeper struct {
 	*vm.VMKeeper
-	// tracks if VmKeeper has been initialized
-	vmInitialized bool
+	// guards the lazy initialization of the VMKeeper, shared by all copies of the keeper
+	vmInitOnce *sync.Once
+	// guards the block nodes cached by the VMKeeper, which are shared by all
+	// the messages and queries and are not synchronized by the VM
+	vmCacheMu *sync.RWMutex
 
 	logger          log.Logger
 	storeService    corestore.KVStoreService
@@ -45,8 +49,19 @@ type Keeper struct {
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
+	// StdlibsChecksum is the checksum of the gno stdlibs loaded at genesis.
+	StdlibsChecksum collections.Item[string]
+	// Packages indexes the deployed packages by path.
+	Packages collections.Map[string, types.Package]
+	// Namespaces holds the claimed namespaces by name.
+	Namespaces collections.Map[string, types.Namespace]
+	// StorageDeposits holds the storage deposits locked by each payer, by
+	// realm path and payer.
+	StorageDeposits collections.Map[collections.Pair[string, string], types.StorageDeposit]
 	// vmKeeperParams manages VM module parameters and state.
 	vmParams *vmKeeperParams
+	// exportFormat is the format of the VM state in the exported genesis.
+	exportFormat types.ExportFormat
 
 	authKeeper types.AuthKeeper
 	bankKeeper types.BankKeeper
@@ -82,22 +97,41 @@ func NewKeeper(
 		addressCodec:    addressCodec,
 		authority:       authority,
 		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		StdlibsChecksum: collections.NewItem(sb, types.StdlibsChecksumKey, "stdlibs_checksum", collections.StringValue),
+		Packages:        collections.NewMap(sb, types.PackagesKey, "packages", collections.StringKey, codec.CollValue[types.Package](cdc)),
+		Namespaces:      collections.NewMap(sb, types.NamespacesKey, "namespaces", collections.StringKey, codec.CollValue[types.Namespace](cdc)),
+		StorageDeposits: collections.NewMap(sb, types.StorageDepositsKey, "storage_deposits", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.StorageDeposit](cdc)),
 		authKeeper:      authKeeper,
 		bankKeeper:      bankKeeper,
+		vmInitOnce:      &sync.Once{},
+		vmCacheMu:       &sync.RWMutex{},
+		exportFormat:    types.ExportFormatKV,
 	}
 	k.vmParams = &vmKeeperParams{k: &k}
 
+	// VMKeeper stores will be initialized lazily when needed
+	k.VMKeeper = vm.NewVMKeeper(
+		k.storeKey,
+		k.memStoreKey,
+		vmAuthKeeper{k.logger, k.authKeeper, k.bankKeeper},
+		k.vmBank(),
+		k.vmParams,
+	)
+
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
 	}
 	k.Schema = schema
 
-	// VMKeeper will be created lazily when needed
-
 	return k
 }
 
+// vmBank returns the wrapper of the bank keeper used by the VM.
+func (k *Keeper) vmBank() vmBankKeeper {
+	return vmBankKeeper{k.logger, k.bankKeeper, k.Params}
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
@@ -105,67 +139,60 @@ func (k Keeper) GetAuthority() []byte {
 
 var defaultChainID = "default_chain_id"
 
-// initializeVMKeeper creates and initializes the VMKeeper with a proper MultiStore.
+// initializeVMKeeper initializes the VMKeeper with a proper MultiStore.
 // This should be called when we have access to a proper SDK context.
-func (k *Keeper) initializeVMKeeper(sdkCtx sdk.Context) error {
-	k.vmParams.SetSDKContext(sdkCtx)
-
-	// check if already initialized to avoid double initialization
-	if k.vmInitialized {
-		return nil
-	}
-
-	// Create a MultiStore wrapper for initialization
-	multiStore := NewGnovmMultiStore(
-		k.logger,
-		k.storeService,
-		k.memStoreService,
-		gnostore.NewStoreKey(k.storeKey.Name()),
-		gnostore.NewStoreKey(k.memStoreKey.Name()),
-	)
+// It is safe for concurrent use, the VMKeeper is only initialized once.
+func (k *Keeper) initializeVMKeeper(sdkCtx sdk.Context) {
+	k.vmInitOnce.Do(func() {
+		// Create a MultiStore wrapper for initialization
+		multiStore := NewGnovmMultiStore(
+			k.logger,
+			k.storeService,
+			k.memStoreService,
+			gnostore.NewStoreKey(k.storeKey.Name()),
+			gnostore.NewStoreKey(k.memStoreKey.Name()),
+		)
+
+		// Create a clean gno context for initialization
+		gnoCtx := gnosdk.NewContext(
+			gnosdk.RunTxModeDeliver,
+			multiStore,
+			k.gnoBlockHeader(sdkCtx),
+			types.NewSlogFromCosmosLogger(k.logger),
+		).WithContext(sdkCtx)
+
+		// Set the context on the multistore after creating the context
+		if lazyStore, ok := multiStore.(*gnovmMultiStore); ok {
+			lazyStore.SetContext(gnoCtx, sdkCtx)
+		}
+
+		// Initialize the VMKeeper with the multistore
+		k.VMKeeper.Initialize(types.NewSlogFromCosmosLogger(k.logger), multiStore)
+	})
+}
 
+// gnoBlockHeader maps the SDK block header to the gno block header, so that
+// realms see the current chain height, block time and proposer.
+func (k *Keeper) gnoBlockHeader(sdkCtx sdk.Context) *bft.Header {
 	chainID := sdkCtx.ChainID()
 	if chainID == "" {
 		k.logger.Debug("chainID is empty when building gno context, using default", "fallback", defaultChainID)
 		chainID = defaultChainID
 	}
 
-	// Create a clean gno context for initialization
-	gnoCtx := gnosdk.NewContext(
-		gnosdk.RunTxModeDeliver,
-		multiStore,
-		&bft.Header{ChainID: chainID},
-		types.NewSlogFromCosmosLogger(k.logger),
-	)
-
-	// Set the context on the multistore after creating the context
-	if lazyStore, ok := multiStore.(*gnovmMultiStore); ok {
-		lazyStore.SetContext(gnoCtx, sdkCtx)
+	return &bft.Header{
+		ChainID:         chainID,
+		Height:          sdkCtx.BlockHeight(),
+		Time:            sdkCtx.BlockTime(),
+		ProposerAddress: types.ToCryptoAddress(sdkCtx.BlockHeader().ProposerAddress),
 	}
-
-	// Create VMKeeper with proper initialization
-	k.VMKeeper = vm.NewVMKeeper(
-		k.storeKey,
-		k.memStoreKey,
-		vmAuthKeeper{k.logger, k.authKeeper, k.bankKeeper, k.vmParams},
-		vmBankKeeper{k.logger, k.bankKeeper, k.vmParams},
-		k.vmParams,
-	)
-
-	// Initialize the VMKeeper with the multistore
-	k.VMKeeper.Initialize(types.NewSlogFromCosmosLogger(k.logger), multiStore)
-	k.vmInitialized = true
-
-	return nil
 }
 
 // BuildGnoContext initializes the VM (if needed), creates a Gno context using
 // the MultiStore wrapper bound to the provided sdkCtx, and returns a per-tx context
 // with a transaction store attached.
 func (k *Keeper) BuildGnoContext(sdkCtx sdk.Context) (gnosdk.Context, error) {
-	if err := k.initializeVMKeeper(sdkCtx); err != nil {
-		return gnosdk.Context{}, err
-	}
+	k.initializeVMKeeper(sdkCtx)
 
 	var mode gnosdk.RunTxMode
 	switch sdkCtx.ExecMode() {
@@ -188,24 +215,56 @@ func (k *Keeper) BuildGnoContext(sdkCtx sdk.Context) (gnosdk.Context, error) {
 		gnostore.NewStoreKey(k.memStoreKey.Name()),
 	)
 
-	chainID := sdkCtx.ChainID()
-	if chainID == "" {
-		k.logger.Debug("chainID is empty when building gno context, using default", "fallback", defaultChainID)
-		chainID = defaultChainID
-	}
-
+	// The SDK context travels with the gno context, so that the keepers given
+	// to the VM use the context of the current call path.
 	gnoCtx := gnosdk.NewContext(
 		mode,
 		ms,
-		&bft.Header{ChainID: chainID},
+		k.gnoBlockHeader(sdkCtx),
 		types.NewSlogFromCosmosLogger(k.logger),
-	)
+	).WithContext(sdkCtx)
 
 	// Set the context on the multistore after creating the context
 	if lazyStore, ok := ms.(*gnovmMultiStore); ok {
 		lazyStore.SetContext(gnoCtx, sdkCtx)
 	}
 
+	// Charge the VM gas on the SDK gas meter, it must be set before creating
+	// the transaction store as the gno store consumes gas on the same meter.
+	gnoCtx = gnoCtx.WithGasMeter(newVMGasMeter(sdkCtx.GasMeter()))
 	gnoCtx = k.VMKeeper.MakeGnoTransactionStore(gnoCtx)
 	return gnoCtx, nil
 }
+
+// readGnoCache locks the block nodes cached by the VM for reading, until the
+// returned function is called. The delivered messages, which are the only ones
+// committing to the cache and are run sequentially, do not lock it.
+func (k *Keeper) readGnoCache(sdkCtx sdk.Context) func() {
+	if sdkCtx.ExecMode() == sdk.ExecModeFinalize {
+		return func() {}
+	}
+
+	k.vmCacheMu.RLock()
+	return k.vmCacheMu.RUnlock
+}
+
+// commitGnoTransactionStore commits the block nodes cached by the transaction
+// store of the gno context to the cache of the VM.
+func (k *Keeper) commitGnoTransactionStore(gnoCtx gnosdk.Context) {
+	k.vmCacheMu.Lock()
+	defer k.vmCacheMu.Unlock()
+
+	k.VMKeeper.CommitGnoTransactionStore(gnoCtx)
+}
+
+// commitDeliveredMessage commits the transaction store of a message, once it
+// succeeded, as gno.land does at the end of the transactions. The messages
+// that are checked or simulated are not committed, so that they leave the
+// cache of the VM untouched.
+func (k *Keeper) commitDeliveredMessage(sdkCtx sdk.Context, gnoCtx gnosdk.Context, err error) {
+	if err != nil || sdkCtx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+
+	k.commitGnoTransactionStore(gnoCtx)
+}
@@ -2,9 +2,14 @@ package keeper_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
@@ -16,6 +21,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	gnosdk "github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/std"
 
 	"github.com/ignite/gnovm/x/gnovm/keeper"
 	module "github.com/ignite/gnovm/x/gnovm/module"
@@ -41,7 +49,8 @@ func initFixture(t *testing.T) *fixture {
 
 	tKey := storetypes.NewTransientStoreKey("transient_test")
 	sdkCtx := testutil.DefaultContextWithDB(t, storeKey, tKey).Ctx
-	sdkCtx = sdkCtx.WithChainID("gnovm-test")
+	// the messages are delivered, as in a block
+	sdkCtx = sdkCtx.WithChainID("gnovm-test").WithExecMode(sdk.ExecModeFinalize)
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
 
@@ -74,3 +83,118 @@ func initFixture(t *testing.T) *fixture {
 		storeService: runtime.NewKVStoreService(storeKey),
 	}
 }
+
+// TestBuildGnoContext_Isolation ensures each gno context uses the SDK context
+// it has been built with, even when other contexts are built in between.
+func TestBuildGnoContext_Isolation(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	callerBytes := f.keeper.GetAuthority()
+	caller := types.ToCryptoAddress(callerBytes)
+
+	ctxA := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1)
+	ctxB := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(2)
+
+	gnoCtxA, err := f.keeper.BuildGnoContext(ctxA)
+	require.NoError(t, err)
+	gnoCtxB, err := f.keeper.BuildGnoContext(ctxB)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		sdkCtx sdk.Context
+		gnoCtx gnosdk.Context
+	}{
+		{sdkCtx: ctxA, gnoCtx: gnoCtxA},
+		{sdkCtx: ctxB, gnoCtx: gnoCtxB},
+	} {
+		f.authKeeper.EXPECT().GetAccount(tc.sdkCtx, callerBytes).
+			Return(authtypes.NewBaseAccountWithAddress(callerBytes))
+		f.bankKeeper.EXPECT().SendCoins(tc.sdkCtx, callerBytes, callerBytes, sdk.NewCoins())
+
+		res, err := f.keeper.VMKeeper.Run(tc.gnoCtx, vm.MsgRun{
+			Caller: caller,
+			Package: &std.MemPackage{
+				Name: "main",
+				Files: []*std.MemFile{
+					{Name: "main.gno", Body: "package main\n\nimport \"chain/runtime\"\n\nfunc main() {\n\tprintln(runtime.ChainHeight())\n}\n"},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("%d\n", tc.sdkCtx.BlockHeight()), res)
+	}
+}
+
+// TestConcurrentQueriesAndCheckTx runs queries and checked messages while
+// messages are delivered, as a node does, to be run with the race detector.
+// Only the delivered messages commit to the cache of the VM.
+func TestConcurrentQueriesAndCheckTx(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+	q := keeper.NewQueryServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	mpkg, err := ReadMemPackageFromDir(filepath.Join("testdata", "counter"))
+	require.NoError(t, err)
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("5000stake")
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, pkgBz))
+	require.NoError(t, err)
+
+	// each message and query is run on its own branch of the state and with
+	// its own gas meter, as the SDK does, the VM being the only state shared
+	// between them
+	const runs = 5
+	branches := func(mode sdk.ExecMode) []sdk.Context {
+		ctxs := make([]sdk.Context, runs)
+		for i := range ctxs {
+			ctxs[i], _ = sdk.UnwrapSDKContext(f.ctx).WithExecMode(mode).CacheContext()
+			ctxs[i] = ctxs[i].WithGasMeter(storetypes.NewInfiniteGasMeter())
+		}
+		return ctxs
+	}
+	deliverCtxs, checkCtxs, queryCtxs := branches(sdk.ExecModeFinalize), branches(sdk.ExecModeCheck), branches(sdk.ExecModeCheck)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 3*runs)
+	// the messages are delivered sequentially
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, ctx := range deliverCtxs {
+			_, err := ms.Call(ctx, types.NewMsgCall(creatorStr, nil, maxDeposit, mpkg.Path, "Increment", nil))
+			errs <- err
+		}
+	}()
+	for i := range runs {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := ms.Call(checkCtxs[i], types.NewMsgCall(creatorStr, nil, maxDeposit, mpkg.Path, "Increment", nil))
+			errs <- err
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := q.Eval(queryCtxs[i], &types.QueryEvalRequest{PkgPath: mpkg.Path, Expr: `Render("")`})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
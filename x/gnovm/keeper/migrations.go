@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 indexes the packages deployed before the packages index was
+// introduced. Their creator is unknown and left empty.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	baseStore, iavlStore := m.keeper.gnoStores(ctx)
+	gs := gno.NewStore(nil, baseStore, iavlStore)
+
+	var err error
+	// the channel must be drained, so errors are only recorded
+	for mpkg := range gs.IterMemPackage() {
+		if err != nil || gno.IsStdlib(mpkg.Path) {
+			continue
+		}
+
+		var has bool
+		has, err = m.keeper.Packages.Has(ctx, mpkg.Path)
+		if err != nil || has {
+			continue
+		}
+		err = m.keeper.indexPackage(ctx, mpkg.Path, "", "")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to index packages: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate2to3 introduces the namespaces: the default namespaces are reserved,
+// and the namespaces of the indexed packages are claimed for their creator.
+// When packages of the same namespace were deployed by different creators,
+// the creator of the first package in path order gets the namespace.
+// The mint permissions are left empty: the realms cannot issue coins until
+// governance grants them a permission.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if len(params.ReservedNamespaces) == 0 {
+		params.ReservedNamespaces = types.DefaultParams().ReservedNamespaces
+		if err := m.keeper.Params.Set(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	err = m.keeper.Packages.Walk(ctx, nil, func(pkgPath string, pkg types.Package) (bool, error) {
+		if pkg.Creator == "" {
+			return false, nil
+		}
+
+		name, owner, err := m.keeper.packageNamespace(ctx, pkgPath)
+		if err != nil || name == "" || owner != "" {
+			return false, err
+		}
+
+		return false, m.keeper.Namespaces.Set(ctx, name, types.Namespace{Name: name, Owner: pkg.Creator})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to claim namespaces: %w", err)
+	}
+
+	return nil
+}
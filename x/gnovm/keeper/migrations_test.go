@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/gnovm/x/gnovm/keeper"
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	deployTestPackages(t, f)
+
+	// drop the index, as for packages deployed before its introduction
+	require.NoError(t, f.keeper.Packages.Clear(f.ctx, nil))
+
+	m := keeper.NewMigrator(&f.keeper)
+	require.NoError(t, m.Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	pkg, err := f.keeper.Packages.Get(f.ctx, "gno.land/r/demo/counter")
+	require.NoError(t, err)
+	require.Equal(t, types.Package{PkgPath: "gno.land/r/demo/counter", Kind: types.PackageKind_PACKAGE_KIND_REALM}, pkg)
+
+	has, err := f.keeper.Packages.Has(f.ctx, "gno.land/p/demo/greet")
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// stdlibs are not indexed
+	has, err = f.keeper.Packages.Has(f.ctx, "strings")
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	f := initFixture(t)
+	creator := deployTestPackages(t, f)
+
+	// drop the namespaces and reserved namespaces, as before their introduction
+	require.NoError(t, f.keeper.Namespaces.Clear(f.ctx, nil))
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.ReservedNamespaces = nil
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	// packages deployed before the packages index have no creator
+	require.NoError(t, f.keeper.Packages.Set(f.ctx, "gno.land/r/legacy/foo", types.Package{PkgPath: "gno.land/r/legacy/foo"}))
+
+	m := keeper.NewMigrator(&f.keeper)
+	require.NoError(t, m.Migrate2to3(sdk.UnwrapSDKContext(f.ctx)))
+
+	params, err = f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams().ReservedNamespaces, params.ReservedNamespaces)
+
+	ns, err := f.keeper.Namespaces.Get(f.ctx, "demo")
+	require.NoError(t, err)
+	require.Equal(t, types.Namespace{Name: "demo", Owner: creator}, ns)
+
+	has, err := f.keeper.Namespaces.Has(f.ctx, "legacy")
+	require.NoError(t, err)
+	require.False(t, has)
+}
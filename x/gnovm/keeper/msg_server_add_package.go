@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,11 +13,19 @@ import (
 	"github.com/ignite/gnovm/x/gnovm/types"
 )
 
-func (k msgServer) AddPackage(ctx context.Context, msg *types.MsgAddPackage) (*types.MsgAddPackageResponse, error) {
+func (k msgServer) AddPackage(ctx context.Context, msg *types.MsgAddPackage) (resp *types.MsgAddPackageResponse, err error) {
 	creatorBytes, err := k.addressCodec.StringToBytes(msg.Creator)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "invalid authority address")
 	}
+	if msg.Admin != "" {
+		if _, err := k.addressCodec.StringToBytes(msg.Admin); err != nil {
+			return nil, errorsmod.Wrap(err, "invalid admin address")
+		}
+	}
+	if err := k.checkDeployer(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	gnoCtx, err := k.BuildGnoContext(sdkCtx)
@@ -26,15 +33,24 @@ func (k msgServer) AddPackage(ctx context.Context, msg *types.MsgAddPackage) (*t
 		return nil, errorsmod.Wrap(err, "failed to initialize VM")
 	}
 
-	send := types.StdCoinsFromSDKCoins(msg.Send)
-	maxDep := types.StdCoinsFromSDKCoins(msg.MaxDeposit)
+	send, err := types.StdCoinsFromSDKCoins(msg.Send)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid send")
+	}
+	maxDep, err := types.StdCoinsFromSDKCoins(msg.MaxDeposit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid max deposit")
+	}
 
 	var mpkg std.MemPackage
 	if err := json.Unmarshal(msg.Package, &mpkg); err != nil {
-		return nil, errorsmod.Wrap(err, "invalid package")
+		return nil, errorsmod.Wrap(types.ErrInvalidPackage, err.Error())
 	}
 	if err := mpkg.ValidateBasic(); err != nil {
-		return nil, errorsmod.Wrap(err, "invalid package")
+		return nil, errorsmod.Wrap(types.ErrInvalidPackage, err.Error())
+	}
+	if err := k.checkNamespace(ctx, mpkg.Path, msg.Creator); err != nil {
+		return nil, err
 	}
 
 	vmMsg := vm.MsgAddPackage{
@@ -44,20 +60,29 @@ func (k msgServer) AddPackage(ctx context.Context, msg *types.MsgAddPackage) (*t
 		MaxDeposit: maxDep,
 	}
 
+	defer k.readGnoCache(sdkCtx)()
 	defer func() {
 		if r := recover(); r != nil {
-			switch rType := r.(type) {
-			default:
-				err = fmt.Errorf("panic while calling VM: %v (%v)", r, rType)
-			}
+			err = recoverVMPanic(r)
 		} else {
-			// this commits the changes to the module store (that is only committed later)
-			k.VMKeeper.CommitGnoTransactionStore(gnoCtx)
+			k.commitDeliveredMessage(sdkCtx, gnoCtx, err)
 		}
 	}()
 
 	if err := k.VMKeeper.AddPackage(gnoCtx, vmMsg); err != nil {
-		return nil, errorsmod.Wrap(err, "failed to add package")
+		emitRealmPanicEvent(sdkCtx, err)
+		return nil, wrapVMError(err, "failed to add package")
+	}
+
+	if err := k.indexPackage(ctx, mpkg.Path, msg.Creator, msg.Admin); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to index package")
+	}
+
+	// forward the events emitted by the package initialization
+	sdkCtx.EventManager().EmitEvents(types.SDKEventsFromGnoEvents(gnoCtx.EventLogger().Events(), ""))
+
+	if err := k.recordStorageDeposits(ctx, msg.Creator, gnoCtx.EventLogger().Events()); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to record storage deposits")
 	}
 
 	return &types.MsgAddPackageResponse{}, nil
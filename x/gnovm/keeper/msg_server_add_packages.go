@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// AddPackages adds the packages of the message in the order of their imports.
+// The packages are added within the same transaction, so an error on any of
+// them reverts the whole deployment.
+func (k msgServer) AddPackages(ctx context.Context, msg *types.MsgAddPackages) (resp *types.MsgAddPackagesResponse, err error) {
+	creatorBytes, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+	if msg.Admin != "" {
+		if _, err := k.addressCodec.StringToBytes(msg.Admin); err != nil {
+			return nil, errorsmod.Wrap(err, "invalid admin address")
+		}
+	}
+	if err := k.checkDeployer(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	mpkgs, err := msg.MemPackages()
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	for _, mpkg := range mpkgs {
+		if err := k.checkNamespace(ctx, mpkg.Path, msg.Creator); err != nil {
+			return nil, err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gnoCtx, err := k.BuildGnoContext(sdkCtx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to initialize VM")
+	}
+
+	if _, err := types.StdCoinsFromSDKCoins(msg.MaxDeposit); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid max deposit")
+	}
+
+	// The VM bounds the deposit of each package, while the max deposit of the
+	// message bounds the deposits of all its packages.
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get params")
+	}
+	defaultDeposit, err := sdk.ParseCoinNormalized(params.DefaultDeposit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid default deposit")
+	}
+	remaining := sdk.Coins(msg.MaxDeposit).AmountOf(defaultDeposit.Denom)
+	if remaining.IsZero() {
+		remaining = defaultDeposit.Amount
+	}
+
+	defer k.readGnoCache(sdkCtx)()
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverVMPanic(r)
+		} else {
+			k.commitDeliveredMessage(sdkCtx, gnoCtx, err)
+		}
+	}()
+
+	pkgPaths := make([]string, 0, len(mpkgs))
+	for _, mpkg := range mpkgs {
+		if !remaining.IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientDeposit, "max deposit exhausted before adding package %s", mpkg.Path)
+		}
+		maxDep, err := types.StdCoinsFromSDKCoins(sdk.NewCoins(sdk.NewCoin(defaultDeposit.Denom, remaining)))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "invalid max deposit")
+		}
+
+		numEvents := len(gnoCtx.EventLogger().Events())
+		vmMsg := vm.MsgAddPackage{
+			Creator:    types.ToCryptoAddress(creatorBytes),
+			Package:    mpkg,
+			MaxDeposit: maxDep,
+		}
+		if err := k.VMKeeper.AddPackage(gnoCtx, vmMsg); err != nil {
+			emitRealmPanicEvent(sdkCtx, err)
+			return nil, wrapVMError(err, "failed to add package "+mpkg.Path)
+		}
+		remaining = remaining.Sub(lockedDeposit(gnoCtx.EventLogger().Events()[numEvents:], defaultDeposit.Denom))
+
+		if err := k.indexPackage(ctx, mpkg.Path, msg.Creator, msg.Admin); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to index package %s", mpkg.Path)
+		}
+		pkgPaths = append(pkgPaths, mpkg.Path)
+	}
+
+	// forward the events emitted by the packages initialization
+	sdkCtx.EventManager().EmitEvents(types.SDKEventsFromGnoEvents(gnoCtx.EventLogger().Events(), ""))
+
+	if err := k.recordStorageDeposits(ctx, msg.Creator, gnoCtx.EventLogger().Events()); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to record storage deposits")
+	}
+
+	return &types.MsgAddPackagesResponse{PkgPaths: pkgPaths}, nil
+}
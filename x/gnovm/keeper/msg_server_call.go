@@ -2,10 +2,10 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
 
 	"github.com/ignite/gnovm/x/gnovm/types"
@@ -23,36 +23,66 @@ func (k msgServer) Call(ctx context.Context, msg *types.MsgCall) (resp *types.Ms
 		return nil, errorsmod.Wrap(err, "failed to initialize VM")
 	}
 
-	vmMsg := vm.MsgCall{
-		Caller:     types.ToCryptoAddress(callerBytes),
-		Send:       types.StdCoinsFromSDKCoins(msg.Send),
-		MaxDeposit: types.StdCoinsFromSDKCoins(msg.MaxDeposit),
-		PkgPath:    msg.PkgPath,
-		Func:       msg.Function,
-		Args:       msg.Args,
+	if msg.JsonArgs != "" && len(msg.Args) > 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "args and json_args cannot be used together")
 	}
 
+	send, err := types.StdCoinsFromSDKCoins(msg.Send)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid send")
+	}
+	maxDep, err := types.StdCoinsFromSDKCoins(msg.MaxDeposit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid max deposit")
+	}
+
+	// the VM panics when calling an unknown package
+	found, err := k.Packages.Has(ctx, msg.PkgPath)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPackageNotFound, "package %s is not deployed", msg.PkgPath)
+	}
+
+	defer k.readGnoCache(sdkCtx)()
 	defer func() {
 		if r := recover(); r != nil {
-			switch rType := r.(type) {
-			default:
-				err = fmt.Errorf("panic while calling VM: %v (%v)", r, rType)
-			}
+			err = recoverVMPanic(r)
 		} else {
-			// this commits the changes to the module store (that is only committed later)
-			k.VMKeeper.CommitGnoTransactionStore(gnoCtx)
+			k.commitDeliveredMessage(sdkCtx, gnoCtx, err)
 		}
 	}()
 
-	result, err := k.VMKeeper.Call(
-		gnoCtx,
-		vmMsg,
-	)
+	vmMsg := vm.MsgCall{
+		Caller:     types.ToCryptoAddress(callerBytes),
+		Send:       send,
+		MaxDeposit: maxDep,
+		PkgPath:    msg.PkgPath,
+		Func:       msg.Function,
+		Args:       msg.Args,
+	}
+
+	var result string
+	if msg.JsonArgs != "" {
+		result, err = k.callWithJSONArgs(gnoCtx, vmMsg, msg.JsonArgs)
+	} else {
+		result, err = k.VMKeeper.Call(gnoCtx, vmMsg)
+	}
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to call VM")
+		emitRealmPanicEvent(sdkCtx, err)
+		return nil, wrapVMError(err, "failed to call VM")
+	}
+
+	// forward the events emitted by the realms
+	sdkCtx.EventManager().EmitEvents(types.SDKEventsFromGnoEvents(gnoCtx.EventLogger().Events(), msg.Function))
+
+	if err := k.recordStorageDeposits(ctx, msg.Caller, gnoCtx.EventLogger().Events()); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to record storage deposits")
 	}
 
 	return &types.MsgCallResponse{
-		Result: result,
+		Result:  result,
+		Results: types.TypedValuesFromGno(result),
 	}, nil
 }
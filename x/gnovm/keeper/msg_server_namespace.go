@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func (k msgServer) ClaimNamespace(ctx context.Context, msg *types.MsgClaimNamespace) (*types.MsgClaimNamespaceResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Owner); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid owner address")
+	}
+	if err := types.ValidateNamespace(msg.Namespace); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := k.namespaceOwner(ctx, params, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if owner != "" {
+		return nil, errorsmod.Wrapf(types.ErrNamespaceClaimed, "namespace %s is owned by %s", msg.Namespace, owner)
+	}
+
+	if err := k.setNamespaceOwner(ctx, msg.Namespace, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimNamespaceResponse{}, nil
+}
+
+func (k msgServer) TransferNamespace(ctx context.Context, msg *types.MsgTransferNamespace) (*types.MsgTransferNamespaceResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.NewOwner); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid new owner address")
+	}
+	if err := k.checkNamespaceOwner(ctx, msg.Owner, msg.Namespace); err != nil {
+		return nil, err
+	}
+
+	if err := k.setNamespaceOwner(ctx, msg.Namespace, msg.NewOwner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferNamespaceResponse{}, nil
+}
+
+func (k msgServer) ReleaseNamespace(ctx context.Context, msg *types.MsgReleaseNamespace) (*types.MsgReleaseNamespaceResponse, error) {
+	if err := k.checkNamespaceOwner(ctx, msg.Owner, msg.Namespace); err != nil {
+		return nil, err
+	}
+
+	if err := k.setNamespaceOwner(ctx, msg.Namespace, ""); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseNamespaceResponse{}, nil
+}
+
+// checkNamespaceOwner checks that the namespace is claimed, and that the signer
+// is either its owner or the module authority.
+func (k msgServer) checkNamespaceOwner(ctx context.Context, signer, name string) error {
+	signerBytes, err := k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	ns, err := k.Namespaces.Get(ctx, name)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "namespace %s is not claimed", name)
+	}
+	if ns.Owner != signer && !bytes.Equal(signerBytes, k.GetAuthority()) {
+		return errorsmod.Wrapf(types.ErrUnauthorizedNamespace, "%s is not the owner of namespace %s", signer, name)
+	}
+
+	return nil
+}
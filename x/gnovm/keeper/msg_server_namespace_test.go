@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/ignite/gnovm/x/gnovm/keeper"
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestMsgNamespaces(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bob_________________"))
+	require.NoError(t, err)
+
+	// claim
+	_, err = ms.ClaimNamespace(f.ctx, &types.MsgClaimNamespace{Owner: alice, Namespace: "team"})
+	require.NoError(t, err)
+	_, err = ms.ClaimNamespace(f.ctx, &types.MsgClaimNamespace{Owner: bob, Namespace: "team"})
+	require.ErrorIs(t, err, types.ErrNamespaceClaimed)
+	_, err = ms.ClaimNamespace(f.ctx, &types.MsgClaimNamespace{Owner: bob, Namespace: "sys"})
+	require.ErrorIs(t, err, types.ErrNamespaceClaimed)
+	_, err = ms.ClaimNamespace(f.ctx, &types.MsgClaimNamespace{Owner: bob, Namespace: alice})
+	require.ErrorIs(t, err, types.ErrNamespaceClaimed)
+	_, err = ms.ClaimNamespace(f.ctx, &types.MsgClaimNamespace{Owner: bob, Namespace: "Team"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// transfer
+	_, err = ms.TransferNamespace(f.ctx, &types.MsgTransferNamespace{Owner: bob, Namespace: "team", NewOwner: bob})
+	require.ErrorIs(t, err, types.ErrUnauthorizedNamespace)
+	_, err = ms.TransferNamespace(f.ctx, &types.MsgTransferNamespace{Owner: alice, Namespace: "team", NewOwner: bob})
+	require.NoError(t, err)
+
+	q := keeper.NewQueryServerImpl(&f.keeper)
+	res, err := q.Namespace(f.ctx, &types.QueryNamespaceRequest{Name: "team"})
+	require.NoError(t, err)
+	require.Equal(t, types.Namespace{Name: "team", Owner: bob}, res.Namespace)
+
+	// release, the authority can release any namespace
+	_, err = ms.ReleaseNamespace(f.ctx, &types.MsgReleaseNamespace{Owner: alice, Namespace: "team"})
+	require.ErrorIs(t, err, types.ErrUnauthorizedNamespace)
+	_, err = ms.ReleaseNamespace(f.ctx, &types.MsgReleaseNamespace{Owner: authority, Namespace: "team"})
+	require.NoError(t, err)
+	_, err = ms.ReleaseNamespace(f.ctx, &types.MsgReleaseNamespace{Owner: bob, Namespace: "team"})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	_, err = q.Namespace(f.ctx, &types.QueryNamespaceRequest{Name: "team"})
+	require.ErrorContains(t, err, "namespace team is not claimed")
+}
+
+func TestNamespacesQuery(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bob_________________"))
+	require.NoError(t, err)
+
+	for owner, names := range map[string][]string{alice: {"a1", "a2"}, bob: {"b1"}} {
+		for _, name := range names {
+			_, err := ms.ClaimNamespace(f.ctx, &types.MsgClaimNamespace{Owner: owner, Namespace: name})
+			require.NoError(t, err)
+		}
+	}
+
+	q := keeper.NewQueryServerImpl(&f.keeper)
+	res, err := q.Namespaces(f.ctx, &types.QueryNamespacesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Namespaces, 3)
+
+	res, err = q.Namespaces(f.ctx, &types.QueryNamespacesRequest{Owner: alice})
+	require.NoError(t, err)
+	require.Equal(t, []types.Namespace{{Name: "a1", Owner: alice}, {Name: "a2", Owner: alice}}, res.Namespaces)
+
+	_, err = q.Namespaces(f.ctx, &types.QueryNamespacesRequest{Owner: "invalid"})
+	require.ErrorContains(t, err, "invalid owner address")
+}
+
+// TestMsgAddPackage_Namespace validates that packages can only be added under
+// the namespaces of their creator, which are claimed by the first package.
+func TestMsgAddPackage_Namespace(t *testing.T) {
+	f := initFixture(t)
+	creator := deployTestPackages(t, f)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	ns, err := f.keeper.Namespaces.Get(f.ctx, "demo")
+	require.NoError(t, err)
+	require.Equal(t, creator, ns.Owner)
+
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bob_________________"))
+	require.NoError(t, err)
+
+	addPackage := func(pkgPath string) error {
+		pkgBz, err := json.Marshal(&std.MemPackage{
+			Name: "squat",
+			Path: pkgPath,
+			Files: []*std.MemFile{
+				{Name: "gnomod.toml", Body: "module = \"" + pkgPath + "\"\ngno = \"0.9\"\n"},
+				{Name: "squat.gno", Body: "package squat\n"},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(bob, nil, nil, pkgBz))
+		return err
+	}
+	require.ErrorIs(t, addPackage("gno.land/r/demo/squat"), types.ErrUnauthorizedNamespace)
+	require.ErrorIs(t, addPackage("gno.land/p/demo/squat"), types.ErrUnauthorizedNamespace)
+	require.ErrorIs(t, addPackage("gno.land/r/sys/squat"), types.ErrUnauthorizedNamespace)
+	require.ErrorIs(t, addPackage("gno.land/r/"+creator+"/squat"), types.ErrUnauthorizedNamespace)
+}
@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,7 +12,7 @@ import (
 	"github.com/ignite/gnovm/x/gnovm/types"
 )
 
-func (k msgServer) Run(ctx context.Context, msg *types.MsgRun) (*types.MsgRunResponse, error) {
+func (k msgServer) Run(ctx context.Context, msg *types.MsgRun) (resp *types.MsgRunResponse, err error) {
 	callerBytes, err := k.addressCodec.StringToBytes(msg.Caller)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to convert caller address")
@@ -25,30 +24,33 @@ func (k msgServer) Run(ctx context.Context, msg *types.MsgRun) (*types.MsgRunRes
 		return nil, errorsmod.Wrap(err, "failed to initialize VM")
 	}
 
-	send := types.StdCoinsFromSDKCoins(msg.Send)
-	maxDep := types.StdCoinsFromSDKCoins(msg.MaxDeposit)
+	send, err := types.StdCoinsFromSDKCoins(msg.Send)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid send")
+	}
+	maxDep, err := types.StdCoinsFromSDKCoins(msg.MaxDeposit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid max deposit")
+	}
 
 	var mpkg std.MemPackage
 	if err := json.Unmarshal(msg.Pkg, &mpkg); err != nil {
-		return nil, errorsmod.Wrap(err, "invalid package")
+		return nil, errorsmod.Wrap(types.ErrInvalidPackage, err.Error())
 	}
 	if err := mpkg.ValidateBasic(); err != nil {
-		return nil, errorsmod.Wrap(err, "invalid package")
+		return nil, errorsmod.Wrap(types.ErrInvalidPackage, err.Error())
 	}
 
+	defer k.readGnoCache(sdkCtx)()
 	defer func() {
 		if r := recover(); r != nil {
-			switch rType := r.(type) {
-			default:
-				err = fmt.Errorf("panic while calling VM: %v (%v)", r, rType)
-			}
+			err = recoverVMPanic(r)
 		} else {
-			// this commits the changes to the module store (that is only committed later)
-			k.VMKeeper.CommitGnoTransactionStore(gnoCtx)
+			k.commitDeliveredMessage(sdkCtx, gnoCtx, err)
 		}
 	}()
 
-	resp, err := k.VMKeeper.Run(
+	result, err := k.VMKeeper.Run(
 		gnoCtx,
 		vm.MsgRun{
 			Caller:     types.ToCryptoAddress(callerBytes),
@@ -58,10 +60,19 @@ func (k msgServer) Run(ctx context.Context, msg *types.MsgRun) (*types.MsgRunRes
 		},
 	)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to run VM")
+		emitRealmPanicEvent(sdkCtx, err)
+		return nil, wrapVMError(err, "failed to run VM")
+	}
+
+	// forward the events emitted by the realms
+	sdkCtx.EventManager().EmitEvents(types.SDKEventsFromGnoEvents(gnoCtx.EventLogger().Events(), "main"))
+
+	if err := k.recordStorageDeposits(ctx, msg.Caller, gnoCtx.EventLogger().Events()); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to record storage deposits")
 	}
 
 	return &types.MsgRunResponse{
-		Result: string(resp),
+		Result:  result,
+		Results: []types.TypedValue{types.NewStringTypedValue(result)},
 	}, nil
 }
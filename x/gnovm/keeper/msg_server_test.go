@@ -1,10 +1,16 @@
 package keeper_test
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/gnolang/gno/gnovm/pkg/gnolang"
@@ -12,8 +18,13 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/crypto"
 	"github.com/gnolang/gno/tm2/pkg/std"
 
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/ignite/gnovm/x/gnovm/keeper"
 	"github.com/ignite/gnovm/x/gnovm/types"
@@ -74,6 +85,8 @@ func TestMsgAddPackage_Success(t *testing.T) {
 		Return(authtypes.NewBaseAccountWithAddress(creatorBytes))
 	// Expected SendCoins for the send parameter
 	pkgAddr := gnolang.DerivePkgCryptoAddr(mpkg.Path).Bytes()
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(f.ctx, gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().BlockedAddr(pkgAddr).Return(false)
 	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, pkgAddr, send)
 	// Expected SendCoins for the storage deposit
 	storageDepositAddr := gnolang.DeriveStorageDepositCryptoAddr(mpkg.Path).Bytes()
@@ -113,6 +126,8 @@ func TestMsgCall_Success(t *testing.T) {
 		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
 	// Expected SendCoins for the send parameter
 	pkgAddr := gnolang.DerivePkgCryptoAddr(mpkg.Path).Bytes()
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(f.ctx, gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().BlockedAddr(pkgAddr).Return(false)
 	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, pkgAddr, send)
 	// Expected SendCoins for the storage deposit
 	storageDepositAddr := gnolang.DeriveStorageDepositCryptoAddr(mpkg.Path).Bytes()
@@ -126,6 +141,8 @@ func TestMsgCall_Success(t *testing.T) {
 	deposit, _ = sdk.ParseCoinsNormalized("5stake")
 
 	// Expected SendCoins for the send parameter
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(f.ctx, gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().BlockedAddr(pkgAddr).Return(false)
 	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, pkgAddr, send)
 	// Expected SendCoins for the storage deposit
 	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, storageDepositAddr, deposit)
@@ -135,6 +152,7 @@ func TestMsgCall_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	require.Contains(t, resp.Result, "1")
+	require.Equal(t, []types.TypedValue{{Type: "int", JsonValue: `"1"`}}, resp.Results)
 }
 
 // TestMsgRun_Success validates running a simple script.
@@ -184,10 +202,122 @@ func main() {
 	resp, err := ms.Run(f.ctx, msg)
 	require.NoError(t, err)
 	require.Equal(t, resp, &types.MsgRunResponse{
-		Result: "Hello, GnoVM!\n",
+		Result:  "Hello, GnoVM!\n",
+		Results: []types.TypedValue{{Type: "string", JsonValue: `"Hello, GnoVM!\n"`}},
 	})
 }
 
+// TestMsgRun_BlockHeader validates that scripts see the SDK block height and time.
+func TestMsgRun_BlockHeader(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	callerStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	callerBytes, err := f.addressCodec.StringToBytes(callerStr)
+	require.NoError(t, err)
+
+	var caddr crypto.Address
+	copy(caddr[:], callerBytes)
+	runPath := "gno.land/e/" + caddr.String() + "/run"
+
+	mpkg := &std.MemPackage{
+		Name: "main",
+		Path: runPath,
+		Files: []*std.MemFile{
+			{
+				Name: "main.gno",
+				Body: `package main
+
+import (
+	"chain/runtime"
+	"time"
+)
+
+func main() {
+	println(runtime.ChainHeight(), time.Now().Unix())
+}
+`,
+			},
+		},
+	}
+
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), callerBytes).
+		Return(authtypes.NewBaseAccountWithAddress(callerBytes))
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), callerBytes, callerBytes, sdk.NewCoins())
+
+	blockTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(42).WithBlockTime(blockTime)
+	msg := types.NewMsgRun(callerStr, sdk.NewCoins(), sdk.NewCoins(), pkgBz)
+
+	resp, err := ms.Run(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("42 %d\n", blockTime.Unix()), resp.Result)
+}
+
+// TestMsgRun_Events validates that events emitted by a script are forwarded
+// to the SDK event manager.
+func TestMsgRun_Events(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	callerStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	callerBytes, err := f.addressCodec.StringToBytes(callerStr)
+	require.NoError(t, err)
+
+	var caddr crypto.Address
+	copy(caddr[:], callerBytes)
+	runPath := "gno.land/e/" + caddr.String() + "/run"
+
+	mpkg := &std.MemPackage{
+		Name: "main",
+		Path: runPath,
+		Files: []*std.MemFile{
+			{
+				Name: "main.gno",
+				Body: `package main
+
+import "chain"
+
+func main() {
+	chain.Emit("Greeting", "name", "gnovm")
+}
+`,
+			},
+		},
+	}
+
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), callerBytes).
+		Return(authtypes.NewBaseAccountWithAddress(callerBytes))
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), callerBytes, callerBytes, sdk.NewCoins())
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+	msg := types.NewMsgRun(callerStr, sdk.NewCoins(), sdk.NewCoins(), pkgBz)
+
+	_, err = ms.Run(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent("Greeting",
+			sdk.NewAttribute("name", "gnovm"),
+			sdk.NewAttribute(types.AttributeKeyPkgPath, runPath),
+			sdk.NewAttribute(types.AttributeKeyFunc, "main"),
+		),
+	}, ctx.EventManager().Events())
+}
+
 // TestMsgRun_Failed ensures MsgRun fails with a minimal invalid package.
 func TestMsgRun_Failed(t *testing.T) {
 	f := initFixture(t)
@@ -231,6 +361,48 @@ func TestMsgRun_Failed(t *testing.T) {
 	require.Contains(t, err.Error(), "failed to run VM")
 }
 
+// TestMsgRun_OutOfGas ensures VM execution is charged on the SDK gas meter and
+// that an infinite loop ends with an out of gas error.
+func TestMsgRun_OutOfGas(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	callerStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	callerBytes, err := f.addressCodec.StringToBytes(callerStr)
+	require.NoError(t, err)
+
+	var caddr crypto.Address
+	copy(caddr[:], callerBytes)
+	runPath := "gno.land/e/" + caddr.String() + "/run"
+
+	mpkg := std.MemPackage{
+		Name: "main",
+		Path: runPath,
+		Files: []*std.MemFile{
+			{Name: "main.gno", Body: "package main\n\nfunc main() {\n\tfor {\n\t}\n}\n"},
+		},
+	}
+	pkgBz, err := json.Marshal(&mpkg)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), callerBytes).
+		Return(authtypes.NewBaseAccountWithAddress(callerBytes))
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), callerBytes, callerBytes, sdk.NewCoins())
+
+	const gasLimit = 10_000_000
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	msg := types.NewMsgRun(callerStr, sdk.NewCoins(), sdk.NewCoins(), pkgBz)
+
+	_, err = ms.Run(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+	require.True(t, ctx.GasMeter().IsPastLimit())
+}
+
 // TestMsgAddPackage_Failed ensures MsgAddPackage fails with a minimal invalid package.
 func TestMsgAddPackage_Failed(t *testing.T) {
 	f := initFixture(t)
@@ -267,7 +439,7 @@ func TestMsgAddPackage_Failed(t *testing.T) {
 	require.Contains(t, err.Error(), "failed to add package")
 }
 
-// TestMsgCall_Failed validates error handling when calling missing realm function.
+// TestMsgCall_Failed validates error handling when calling a missing realm.
 func TestMsgCall_Failed(t *testing.T) {
 	f := initFixture(t)
 	ms := keeper.NewMsgServerImpl(&f.keeper)
@@ -287,8 +459,7 @@ func TestMsgCall_Failed(t *testing.T) {
 	}
 
 	_, err = ms.Call(f.ctx, msg)
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "panic while calling VM")
+	require.ErrorIs(t, err, types.ErrPackageNotFound)
 }
 
 // TestMsgCall_Transfer validates calling the faucet.Transfer function which
@@ -319,6 +490,8 @@ func TestMsgCall_Transfer(t *testing.T) {
 		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
 	// Expected SendCoins for the send parameter
 	pkgAddr := gnolang.DerivePkgCryptoAddr(mpkg.Path).Bytes()
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(f.ctx, gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().BlockedAddr(pkgAddr).Return(false)
 	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, pkgAddr, send)
 	// Expected SendCoins for the storage deposit
 	storageDepositAddr := gnolang.DeriveStorageDepositCryptoAddr(mpkg.Path).Bytes()
@@ -333,8 +506,12 @@ func TestMsgCall_Transfer(t *testing.T) {
 
 	maxDeposit, _ = sdk.ParseCoinsNormalized("0stake") // no storage deposit
 	// Expected SendCoins for the send parameter
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(f.ctx, gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().BlockedAddr(pkgAddr).Return(false)
 	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, pkgAddr, send)
 	// Expected SendCoins for the transfer realm
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(f.ctx, gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().BlockedAddr(creatorBytes).Return(false)
 	f.bankKeeper.EXPECT().SendCoins(f.ctx, pkgAddr, creatorBytes, sdk.NewCoins(sdk.NewInt64Coin("ugnot", 1)))
 
 	callMsg := types.NewMsgCall(creatorStr, send, maxDeposit, mpkg.Path, "Transfer", nil)
@@ -342,3 +519,774 @@ func TestMsgCall_Transfer(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 }
+
+// TestMsgCall_RestrictedDenoms validates that the restricted denoms cannot be
+// transferred through the VM, while they still pay the storage deposits.
+func TestMsgCall_RestrictedDenoms(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	params := types.DefaultParams()
+	params.RestrictedDenoms = []string{"stake", "ugnot"}
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: params}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	mpkg, err := ReadMemPackageFromDir(filepath.Join("testdata", "faucet"))
+	require.NoError(t, err)
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(f.ctx, creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	pkgAddr := gnolang.DerivePkgCryptoAddr(mpkg.Path).Bytes()
+	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, pkgAddr, sdk.NewCoins()).AnyTimes()
+	// the storage deposit is sent even though its denom is restricted
+	storageDepositAddr := gnolang.DeriveStorageDepositCryptoAddr(mpkg.Path).Bytes()
+	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, storageDepositAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 2115)))
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("5000stake")
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, pkgBz))
+	require.NoError(t, err)
+
+	// coins in a restricted denom cannot be sent to a realm
+	send := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, send, nil, mpkg.Path, "Transfer", nil))
+	require.ErrorIs(t, err, types.ErrRestrictedDenom)
+
+	_, err = ms.Call(f.ctx, &types.MsgCall{Caller: creatorStr, Send: send, PkgPath: mpkg.Path, Function: "Transfer", JsonArgs: "[]"})
+	require.ErrorIs(t, err, types.ErrRestrictedDenom)
+
+	// nor sent by a realm, which panics
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, nil, mpkg.Path, "Transfer", nil))
+	require.ErrorIs(t, err, types.ErrRealmPanic)
+	require.ErrorContains(t, err, "ugnot cannot be transferred through the VM")
+}
+
+// TestMsgCall_BankPolicies validates that the transfers through the VM are
+// subject to the send enabled denoms and blocked addresses of the bank, unlike
+// the storage deposits.
+func TestMsgCall_BankPolicies(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	mpkg, err := ReadMemPackageFromDir(filepath.Join("testdata", "faucet"))
+	require.NoError(t, err)
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(f.ctx, creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	pkgAddr := gnolang.DerivePkgCryptoAddr(mpkg.Path).Bytes()
+	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, pkgAddr, sdk.NewCoins()).AnyTimes()
+	// the storage deposit is not checked against the bank policies
+	storageDepositAddr := gnolang.DeriveStorageDepositCryptoAddr(mpkg.Path).Bytes()
+	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, storageDepositAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 2115)))
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("5000stake")
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, pkgBz))
+	require.NoError(t, err)
+
+	send := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	// a denom whose transfers are disabled cannot be sent to a realm
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(f.ctx, gomock.Any()).Return(banktypes.ErrSendDisabled)
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, send, nil, mpkg.Path, "Transfer", nil))
+	require.ErrorIs(t, err, banktypes.ErrSendDisabled)
+
+	// nor coins sent to a blocked address
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(f.ctx, gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().BlockedAddr(pkgAddr).Return(true)
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, send, nil, mpkg.Path, "Transfer", nil))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// including by a realm, which panics
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(f.ctx, gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().BlockedAddr(creatorBytes).Return(true)
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, nil, mpkg.Path, "Transfer", nil))
+	require.ErrorIs(t, err, types.ErrRealmPanic)
+	require.ErrorContains(t, err, "is not allowed to receive funds")
+}
+
+// TestMsgCall_MintPermissions validates that realms only issue the coins of
+// the denoms they are allowed to, up to their supply cap.
+func TestMsgCall_MintPermissions(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	mpkg, err := ReadMemPackageFromDir(filepath.Join("testdata", "token"))
+	require.NoError(t, err)
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+	pkgPath := mpkg.Path
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("5000stake")
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, pkgBz))
+	require.NoError(t, err)
+
+	denom := types.RealmDenom(pkgPath, "tok")
+
+	// the realm cannot issue coins without a permission
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, nil, pkgPath, "Mint", []string{"60"}))
+	require.ErrorIs(t, err, types.ErrRealmPanic)
+	require.ErrorContains(t, err, denom+" has no mint permission")
+
+	params := types.DefaultParams()
+	params.MintPermissions = []types.MintPermission{{PkgPath: pkgPath, Denom: denom, SupplyCap: sdkmath.NewInt(100)}}
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: creatorStr, Params: params})
+	require.NoError(t, err)
+
+	minted := sdk.NewCoins(sdk.NewInt64Coin(denom, 60))
+	f.bankKeeper.EXPECT().GetSupply(gomock.Any(), denom).Return(sdk.NewInt64Coin(denom, 0))
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().BlockedAddr(sdk.AccAddress(creatorBytes)).Return(false)
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, minted)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(creatorBytes), minted)
+	f.bankKeeper.EXPECT().HasDenomMetaData(gomock.Any(), denom).Return(true)
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), sdk.AccAddress(creatorBytes)).Return(minted)
+
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, nil, pkgPath, "Mint", []string{"60"}))
+	require.NoError(t, err)
+
+	// nor beyond the supply cap of the permission
+	f.bankKeeper.EXPECT().GetSupply(gomock.Any(), denom).Return(sdk.NewInt64Coin(denom, 60))
+
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, nil, pkgPath, "Mint", []string{"60"}))
+	require.ErrorIs(t, err, types.ErrRealmPanic)
+	require.ErrorContains(t, err, "supply of "+denom+" would exceed its cap of 100")
+}
+
+// TestMsgCall_RealmDenoms validates that the coins issued by realms are bank
+// coins, with their metadata, which are burned when removed by the realm.
+func TestMsgCall_RealmDenoms(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	mpkg, err := ReadMemPackageFromDir(filepath.Join("testdata", "token"))
+	require.NoError(t, err)
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	denom := types.RealmDenom(mpkg.Path, "tok")
+	params := types.DefaultParams()
+	params.MintPermissions = []types.MintPermission{{PkgPath: mpkg.Path, Denom: denom}}
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: params}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("5000stake")
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, pkgBz))
+	require.NoError(t, err)
+
+	// the metadata of the denom is registered when it is first issued
+	minted := sdk.NewCoins(sdk.NewInt64Coin(denom, 60))
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().BlockedAddr(sdk.AccAddress(creatorBytes)).Return(false)
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, minted)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(creatorBytes), minted)
+	f.bankKeeper.EXPECT().HasDenomMetaData(gomock.Any(), denom).Return(false)
+	f.bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), banktypes.Metadata{
+		Description: "Coins issued by the realm gno.land/r/demo/token",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        "tok",
+		Symbol:      "TOK",
+	})
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), sdk.AccAddress(creatorBytes)).Return(minted)
+
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, nil, mpkg.Path, "Mint", []string{"60"}))
+	require.NoError(t, err)
+
+	// the coins removed by the realm are burned
+	burned := sdk.NewCoins(sdk.NewInt64Coin(denom, 20))
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), sdk.AccAddress(creatorBytes)).Return(minted)
+	f.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(creatorBytes), types.ModuleName, burned)
+	f.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, burned)
+
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, nil, mpkg.Path, "Burn", []string{"20"}))
+	require.NoError(t, err)
+}
+
+// TestMsgCall_JSONArgs validates calling a function with composite arguments.
+func TestMsgCall_JSONArgs(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	mpkg, err := ReadMemPackageFromDir(filepath.Join("testdata", "orders"))
+	require.NoError(t, err)
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	send, _ := sdk.ParseCoinsNormalized("1000stake")
+	maxDeposit, _ := sdk.ParseCoinsNormalized("10000stake")
+
+	f.authKeeper.EXPECT().GetAccount(f.ctx, creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	// the send parameter is sent to the realm by the calls with builtin and
+	// composite arguments alike
+	pkgAddr := gnolang.DerivePkgCryptoAddr(mpkg.Path).Bytes()
+	storageAddr := gnolang.DeriveStorageDepositCryptoAddr(mpkg.Path).Bytes()
+	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, pkgAddr, sdk.Coins{}).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, pkgAddr, send).Times(2)
+	f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, storageAddr, gomock.Any()).AnyTimes()
+	f.bankKeeper.EXPECT().IsSendEnabledCoins(f.ctx, gomock.Any()).Return(nil).AnyTimes()
+	f.bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, pkgBz))
+	require.NoError(t, err)
+
+	// builtin arguments are passed to the function as with MsgCall
+	tipMsg := types.NewMsgCall(creatorStr, send, maxDeposit, mpkg.Path, "Tip", nil)
+	tipMsg.JsonArgs = `["thanks"]`
+	resp, err := ms.Call(f.ctx, tipMsg)
+	require.NoError(t, err)
+	require.Equal(t, "(\"thanks\" string)\n(\"1000stake\" string)\n\n", resp.Result)
+
+	// composite arguments are passed to the function as with MsgCall
+	payMsg := types.NewMsgCall(creatorStr, send, maxDeposit, mpkg.Path, "Pay", nil)
+	payMsg.JsonArgs = fmt.Sprintf(`[{"Buyer": %q}]`, creatorStr)
+	resp, err = ms.Call(f.ctx, payMsg)
+	require.NoError(t, err)
+	require.Equal(t, "(\"1000stake\" string)\n\n", resp.Result)
+
+	callMsg := types.NewMsgCall(creatorStr, nil, maxDeposit, mpkg.Path, "Place", nil)
+	callMsg.JsonArgs = fmt.Sprintf(`[{
+		"Buyer": %q,
+		"Items": [{"Name": "apple", "Quantity": 2}, {"Name": "pear", "Quantity": 3}],
+		"Price": "10stake",
+		"Tags": {"note": "fragile"}
+	}]`, creatorStr)
+	resp, err = ms.Call(f.ctx, callMsg)
+	require.NoError(t, err)
+	require.Equal(t, "(5 int)\n(\"fragile\" string)\n\n", resp.Result)
+	require.Equal(t, []types.TypedValue{
+		{Type: "int", JsonValue: `"5"`},
+		{Type: "string", JsonValue: `"fragile"`},
+	}, resp.Results)
+
+	tests := []struct {
+		name     string
+		args     []string
+		jsonArgs string
+		err      string
+	}{
+		{
+			name:     "args and json args",
+			args:     []string{"1"},
+			jsonArgs: `[{}]`,
+			err:      "args and json_args cannot be used together",
+		},
+		{
+			name:     "not an array",
+			jsonArgs: `{}`,
+			err:      "invalid JSON arguments in call to Place",
+		},
+		{
+			name:     "wrong number of arguments",
+			jsonArgs: `[{}, {}]`,
+			err:      "wrong number of arguments in call to Place: want 1 got 2",
+		},
+		{
+			name:     "invalid address",
+			jsonArgs: `[{"Buyer": "alice"}]`,
+			err:      `invalid argument #1 (order) in call to Place: field Buyer: expected a bech32 address, got "alice"`,
+		},
+		{
+			name:     "invalid coins",
+			jsonArgs: `[{"Price": "ten"}]`,
+			err:      `invalid argument #1 (order) in call to Place: field Price: expected coins, got "ten"`,
+		},
+		{
+			name:     "unknown field",
+			jsonArgs: `[{"Discount": 10}]`,
+			err:      "invalid argument #1 (order) in call to Place: unknown field Discount",
+		},
+		{
+			name:     "unknown nested field",
+			jsonArgs: `[{"Items": [{"Color": "red"}]}]`,
+			err:      "invalid argument #1 (order) in call to Place: field Items: index 0: unknown field Color",
+		},
+		{
+			name:     "invalid nested field",
+			jsonArgs: `[{"Items": [{"Quantity": "two"}]}]`,
+			err:      `invalid argument #1 (order) in call to Place: field Items: index 0: field Quantity: expected int, got "two"`,
+		},
+		{
+			name:     "invalid map",
+			jsonArgs: `[{"Tags": ["fragile"]}]`,
+			err:      `invalid argument #1 (order) in call to Place: field Tags: expected a JSON object, got ["fragile"]`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCall(creatorStr, nil, maxDeposit, mpkg.Path, "Place", tc.args)
+			msg.JsonArgs = tc.jsonArgs
+			_, err := ms.Call(f.ctx, msg)
+			require.ErrorContains(t, err, tc.err)
+			require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		})
+	}
+}
+
+// TestMsgAddPackages_Success validates adding a realm along with the package it
+// imports, given in reverse order.
+func TestMsgAddPackages_Success(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	for _, path := range []string{"gno.land/p/demo/greet", "gno.land/r/demo/hello"} {
+		pkgAddr := gnolang.DerivePkgCryptoAddr(path).Bytes()
+		f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, pkgAddr, gomock.Any())
+		storageDepositAddr := gnolang.DeriveStorageDepositCryptoAddr(path).Bytes()
+		f.bankKeeper.EXPECT().SendCoins(f.ctx, creatorBytes, storageDepositAddr, gomock.Any())
+	}
+
+	hello, err := CreateMemPackageFromFiles("hello", "gno.land/r/demo/hello", map[string]string{
+		"gnomod.toml": "module = \"gno.land/r/demo/hello\"\ngno = \"0.9\"\n",
+		"hello.gno":   "package hello\n\nimport \"gno.land/p/demo/greet\"\n\nfunc Render(_ string) string { return greet.Hello() }\n",
+	})
+	require.NoError(t, err)
+	hello.Sort()
+	greet, err := CreateMemPackageFromFiles("greet", "gno.land/p/demo/greet", map[string]string{
+		"gnomod.toml": "module = \"gno.land/p/demo/greet\"\ngno = \"0.9\"\n",
+		"greet.gno":   "package greet\n\nfunc Hello() string { return \"hello\" }\n",
+	})
+	require.NoError(t, err)
+	greet.Sort()
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("5000stake")
+	msg, err := types.NewMsgAddPackages(creatorStr, maxDeposit, []*std.MemPackage{hello, greet})
+	require.NoError(t, err)
+
+	resp, err := ms.AddPackages(f.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"gno.land/p/demo/greet", "gno.land/r/demo/hello"}, resp.PkgPaths)
+
+	q := keeper.NewQueryServerImpl(&f.keeper)
+	res, err := q.Eval(f.ctx, &types.QueryEvalRequest{PkgPath: "gno.land/r/demo/hello", Expr: `Render("")`})
+	require.NoError(t, err)
+	require.Equal(t, `("hello" string)`, res.Result)
+}
+
+// TestMsgAddPackages_Failed validates that the failing package is reported, and
+// that import cycles are rejected before adding any package.
+func TestMsgAddPackages_Failed(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	alpha, err := CreateMemPackageFromFiles("alpha", "gno.land/p/demo/alpha", map[string]string{
+		"gnomod.toml": "module = \"gno.land/p/demo/alpha\"\ngno = \"0.9\"\n",
+		"alpha.gno":   "package alpha\n\nconst A = 1\n",
+	})
+	require.NoError(t, err)
+	alpha.Sort()
+	beta, err := CreateMemPackageFromFiles("beta", "gno.land/p/demo/beta", map[string]string{
+		"gnomod.toml": "module = \"gno.land/p/demo/beta\"\ngno = \"0.9\"\n",
+		"beta.gno":    "package beta\n\nimport \"gno.land/p/demo/alpha\"\n\nconst B = alpha.A + undefined\n",
+	})
+	require.NoError(t, err)
+	beta.Sort()
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("5000stake")
+	msg, err := types.NewMsgAddPackages(creatorStr, maxDeposit, []*std.MemPackage{beta, alpha})
+	require.NoError(t, err)
+
+	_, err = ms.AddPackages(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrTypeCheck)
+	require.ErrorContains(t, err, "failed to add package gno.land/p/demo/beta")
+
+	gamma, err := CreateMemPackageFromFiles("gamma", "gno.land/p/demo/gamma", map[string]string{
+		"gnomod.toml": "module = \"gno.land/p/demo/gamma\"\ngno = \"0.9\"\n",
+		"gamma.gno":   "package gamma\n\nimport \"gno.land/p/demo/delta\"\n\nconst C = delta.D\n",
+	})
+	require.NoError(t, err)
+	gamma.Sort()
+	delta, err := CreateMemPackageFromFiles("delta", "gno.land/p/demo/delta", map[string]string{
+		"gnomod.toml": "module = \"gno.land/p/demo/delta\"\ngno = \"0.9\"\n",
+		"delta.gno":   "package delta\n\nimport \"gno.land/p/demo/gamma\"\n\nconst D = gamma.C\n",
+	})
+	require.NoError(t, err)
+	delta.Sort()
+
+	msg, err = types.NewMsgAddPackages(creatorStr, maxDeposit, []*std.MemPackage{gamma, delta})
+	require.NoError(t, err)
+
+	_, err = ms.AddPackages(f.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "import cycle between packages: gno.land/p/demo/gamma -> gno.land/p/demo/delta -> gno.land/p/demo/gamma")
+}
+
+// TestMsgAddPackages_MaxDeposit validates that the max deposit bounds the
+// deposits of all the packages, rather than the deposit of each of them.
+func TestMsgAddPackages_MaxDeposit(t *testing.T) {
+	pkgs := make([]*std.MemPackage, 0, 2)
+	for _, name := range []string{"first", "second"} {
+		path := "gno.land/p/demo/" + name
+		mpkg, err := CreateMemPackageFromFiles(name, path, map[string]string{
+			"gnomod.toml": "module = \"" + path + "\"\ngno = \"0.9\"\n",
+			name + ".gno": "package " + name + "\n\nvar Names = []string{\"a\", \"b\", \"c\"}\n",
+		})
+		require.NoError(t, err)
+		mpkg.Sort()
+		pkgs = append(pkgs, mpkg)
+	}
+
+	addPackages := func(maxDeposit sdk.Coins) (*fixture, string, error) {
+		f := initFixture(t)
+		ms := keeper.NewMsgServerImpl(&f.keeper)
+		require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+		creatorBytes := f.keeper.GetAuthority()
+		creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+		require.NoError(t, err)
+		f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+			Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+		f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+		msg, err := types.NewMsgAddPackages(creatorStr, maxDeposit, pkgs)
+		require.NoError(t, err)
+		_, err = ms.AddPackages(f.ctx, msg)
+		return f, creatorStr, err
+	}
+
+	f, creatorStr, err := addPackages(sdk.NewCoins(sdk.NewInt64Coin("stake", 100000)))
+	require.NoError(t, err)
+	var deposits []sdkmath.Int
+	for _, mpkg := range pkgs {
+		deposit, err := f.keeper.StorageDeposits.Get(f.ctx, collections.Join(mpkg.Path, creatorStr))
+		require.NoError(t, err)
+		deposits = append(deposits, deposit.Amount.AmountOf("stake"))
+	}
+
+	// enough for the deposit of each package, but not for their total
+	maxDeposit := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.MaxInt(deposits[0], deposits[1])))
+	_, _, err = addPackages(maxDeposit)
+	require.ErrorIs(t, err, types.ErrInsufficientDeposit)
+
+	_, _, err = addPackages(sdk.NewCoins(sdk.NewCoin("stake", deposits[0].Add(deposits[1]))))
+	require.NoError(t, err)
+}
+
+// TestMsgAddPackage_DeploymentPolicy validates that the creators are checked
+// against the deployment policy before the package is added.
+func TestMsgAddPackage_DeploymentPolicy(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bob_________________"))
+	require.NoError(t, err)
+
+	// the package is invalid, so that allowed creators fail after the check
+	addPackage := func(creator string) error {
+		_, err := ms.AddPackage(f.ctx, types.NewMsgAddPackage(creator, nil, nil, []byte("{}")))
+		return err
+	}
+
+	tests := []struct {
+		policy     types.DeploymentPolicy
+		allowlist  []string
+		authorized []string
+		rejected   []string
+	}{
+		{
+			policy:     types.DeploymentPolicy_DEPLOYMENT_POLICY_OPEN,
+			authorized: []string{authority, alice, bob},
+		},
+		{
+			policy:     types.DeploymentPolicy_DEPLOYMENT_POLICY_ALLOWLIST,
+			allowlist:  []string{alice},
+			authorized: []string{authority, alice},
+			rejected:   []string{bob},
+		},
+		{
+			policy:     types.DeploymentPolicy_DEPLOYMENT_POLICY_GOV_ONLY,
+			authorized: []string{authority},
+			rejected:   []string{alice, bob},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.policy.String(), func(t *testing.T) {
+			params := types.DefaultParams()
+			params.DeploymentPolicy = tc.policy
+			params.DeploymentAllowlist = tc.allowlist
+			_, err := ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+			require.NoError(t, err)
+
+			for _, creator := range tc.authorized {
+				err := addPackage(creator)
+				require.ErrorContains(t, err, "invalid package")
+				require.NotErrorIs(t, err, types.ErrUnauthorizedDeployer)
+			}
+			for _, creator := range tc.rejected {
+				require.ErrorIs(t, addPackage(creator), types.ErrUnauthorizedDeployer)
+			}
+		})
+	}
+}
+
+// TestMsgServer_VMErrors ensures the VM failures are reported with the module
+// error of their category.
+func TestMsgServer_VMErrors(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	newPackage := func(path, body string) []byte {
+		mpkg, err := CreateMemPackageFromFiles("boom", path, map[string]string{
+			"gnomod.toml": fmt.Sprintf("module = %q\ngno = \"0.9\"\n", path),
+			"boom.gno":    body,
+		})
+		require.NoError(t, err)
+		mpkg.Sort()
+		pkgBz, err := json.Marshal(mpkg)
+		require.NoError(t, err)
+		return pkgBz
+	}
+	realm := newPackage("gno.land/r/demo/boom", "package boom\n\nfunc Boom(_ realm) {\n\tpanic(\"boom\")\n}\n")
+	maxDeposit, _ := sdk.ParseCoinsNormalized("5000stake")
+
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, realm))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		run  func() error
+		err  error
+		log  string
+	}{
+		{
+			name: "invalid package",
+			run: func() error {
+				_, err := ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, []byte("{}")))
+				return err
+			},
+			err: types.ErrInvalidPackage,
+		},
+		{
+			name: "type check",
+			run: func() error {
+				pkgBz := newPackage("gno.land/r/demo/typo", "package boom\n\nvar x int = \"x\"\n")
+				_, err := ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, pkgBz))
+				return err
+			},
+			err: types.ErrTypeCheck,
+		},
+		{
+			name: "package exists",
+			run: func() error {
+				_, err := ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, realm))
+				return err
+			},
+			err: types.ErrPackageExists,
+		},
+		{
+			name: "insufficient deposit",
+			run: func() error {
+				pkgBz := newPackage("gno.land/r/demo/cheap", "package boom\n\nvar x = 1\n")
+				_, err := ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), pkgBz))
+				return err
+			},
+			err: types.ErrInsufficientDeposit,
+		},
+		{
+			name: "realm panic",
+			run: func() error {
+				ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+				_, err := ms.Call(ctx, types.NewMsgCall(creatorStr, nil, maxDeposit, "gno.land/r/demo/boom", "Boom", nil))
+
+				// the stacktrace is emitted as an event
+				events := ctx.EventManager().Events()
+				require.Len(t, events, 1)
+				require.Equal(t, types.EventTypeRealmPanic, events[0].Type)
+				require.Equal(t, types.AttributeKeyStacktrace, events[0].Attributes[0].Key)
+				require.Equal(t, "panic: boom\ngno.land/r/demo/boom/boom.gno:4 pkg.Boom", events[0].Attributes[0].Value)
+				return err
+			},
+			err: types.ErrRealmPanic,
+			// the log includes the location and the function of each frame
+			log: "panic: boom\ngno.land/r/demo/boom/boom.gno:4 pkg.Boom",
+		},
+		{
+			name: "package not found",
+			run: func() error {
+				_, err := ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, maxDeposit, "gno.land/r/demo/unknown", "Boom", nil))
+				return err
+			},
+			err: types.ErrPackageNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.run()
+			require.ErrorIs(t, err, tc.err)
+			require.ErrorContains(t, err, tc.log)
+		})
+	}
+}
+
+// TestMsgCall_StorageDepositRefund ensures the storage deposit unlocked when a
+// realm releases storage is refunded to the caller.
+func TestMsgCall_StorageDepositRefund(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	const pkgPath = "gno.land/r/demo/notes"
+	mpkg, err := CreateMemPackageFromFiles("notes", pkgPath, map[string]string{
+		"gnomod.toml": "module = \"gno.land/r/demo/notes\"\ngno = \"0.9\"\n",
+		"notes.gno":   "package notes\n\nvar notes []string\n\nfunc Add(_ realm, note string) {\n\tnotes = append(notes, note)\n}\n\nfunc Clear(_ realm) {\n\tnotes = nil\n}\n",
+	})
+	require.NoError(t, err)
+	mpkg.Sort()
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	// the refund is sent from the storage deposit address of the realm
+	var refund sdk.Coins
+	storageDepositAddr := gnolang.DeriveStorageDepositCryptoAddr(pkgPath).Bytes()
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), storageDepositAddr, creatorBytes, gomock.Any()).
+		Do(func(_ context.Context, _, _ sdk.AccAddress, amt sdk.Coins) { refund = amt })
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("100000stake")
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, pkgBz))
+	require.NoError(t, err)
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, maxDeposit, pkgPath, "Add", []string{strings.Repeat("a", 200)}))
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+	_, err = ms.Call(ctx, types.NewMsgCall(creatorStr, nil, maxDeposit, pkgPath, "Clear", nil))
+	require.NoError(t, err)
+	require.True(t, refund.IsAllPositive())
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, sdk.NewEvent(types.EventTypePayerStorageDeposit,
+		sdk.NewAttribute(types.AttributeKeyPayer, creatorStr),
+		sdk.NewAttribute(types.AttributeKeyLocked, ""),
+		sdk.NewAttribute(types.AttributeKeyUnlocked, refund.String()),
+		sdk.NewAttribute(types.AttributeKeyRefunded, refund.String()),
+	), events[len(events)-1])
+}
+
+// TestMsgCall_LargeAmounts ensures the amounts overflowing the int64 amounts
+// of the VM are rejected when sent, and capped when read by a realm.
+func TestMsgCall_LargeAmounts(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	pkgPath := "gno.land/r/demo/balance"
+	mpkg, err := CreateMemPackageFromFiles("balance", pkgPath, map[string]string{
+		"gnomod.toml": fmt.Sprintf("module = %q\ngno = \"0.9\"\n", pkgPath),
+		"balance.gno": `package balance
+
+import (
+	"chain/banker"
+	"chain/runtime"
+)
+
+func Balance(_ realm) string {
+	return banker.NewBanker(banker.BankerTypeReadonly).GetCoins(runtime.OriginCaller()).String()
+}
+`,
+	})
+	require.NoError(t, err)
+	mpkg.Sort()
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("5000stake")
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, pkgBz))
+	require.NoError(t, err)
+
+	overflow := sdkmath.NewInt(math.MaxInt64).MulRaw(1000)
+
+	// sending more than the VM can represent fails before calling it
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, sdk.NewCoins(sdk.NewCoin("stake", overflow)), nil, pkgPath, "Balance", nil))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), sdk.AccAddress(creatorBytes)).
+		Return(sdk.NewCoins(sdk.NewCoin("stake", overflow))).AnyTimes()
+
+	resp, err := ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, nil, pkgPath, "Balance", nil))
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf(`("%dstake" string)`, int64(math.MaxInt64))+"\n\n", resp.Result)
+}
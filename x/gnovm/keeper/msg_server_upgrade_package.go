@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// UpgradePackage replaces the code of a deployed package, keeping the state of
+// the realm. Only the creator of the package, its admin and the module
+// authority can upgrade it.
+func (k msgServer) UpgradePackage(ctx context.Context, msg *types.MsgUpgradePackage) (resp *types.MsgUpgradePackageResponse, err error) {
+	senderBytes, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	maxDep, err := types.StdCoinsFromSDKCoins(msg.MaxDeposit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid max deposit")
+	}
+
+	var mpkg std.MemPackage
+	if err := json.Unmarshal(msg.Package, &mpkg); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPackage, err.Error())
+	}
+	if err := mpkg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPackage, err.Error())
+	}
+
+	pkg, err := k.Packages.Get(ctx, mpkg.Path)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(types.ErrPackageNotFound, "package %s not found", mpkg.Path)
+	} else if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get package")
+	}
+	if msg.Sender != pkg.Creator && msg.Sender != pkg.Admin && !bytes.Equal(senderBytes, k.GetAuthority()) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorizedUpgrade, "%s is not the creator or the admin of package %s", msg.Sender, mpkg.Path)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gnoCtx, err := k.BuildGnoContext(sdkCtx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to initialize VM")
+	}
+
+	defer k.readGnoCache(sdkCtx)()
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverVMPanic(r)
+		} else {
+			k.commitDeliveredMessage(sdkCtx, gnoCtx, err)
+		}
+	}()
+
+	if err := k.upgradePackage(sdkCtx, gnoCtx, types.ToCryptoAddress(senderBytes), &mpkg, maxDep); err != nil {
+		emitRealmPanicEvent(sdkCtx, err)
+		return nil, wrapVMError(err, "failed to upgrade package")
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUpgradePackage,
+		sdk.NewAttribute(types.AttributeKeyPkgPath, mpkg.Path),
+		sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+	))
+	// forward the events emitted by the new variables initialization
+	sdkCtx.EventManager().EmitEvents(types.SDKEventsFromGnoEvents(gnoCtx.EventLogger().Events(), ""))
+
+	if err := k.recordStorageDeposits(ctx, msg.Sender, gnoCtx.EventLogger().Events()); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to record storage deposits")
+	}
+
+	return &types.MsgUpgradePackageResponse{}, nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ignite/gnovm/x/gnovm/keeper"
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestMsgUpgradePackage(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+	q := keeper.NewQueryServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bob_________________"))
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, addr sdk.AccAddress) sdk.AccountI {
+			return authtypes.NewBaseAccountWithAddress(addr)
+		}).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("100000stake")
+	newPackage := func(name, path string, files map[string]string) []byte {
+		files["gnomod.toml"] = fmt.Sprintf("module = %q\ngno = \"0.9\"\n", path)
+		mpkg, err := CreateMemPackageFromFiles(name, path, files)
+		require.NoError(t, err)
+		mpkg.Sort()
+		bz, err := json.Marshal(mpkg)
+		require.NoError(t, err)
+		return bz
+	}
+	eval := func(pkgPath, expr string) string {
+		res, err := q.Eval(f.ctx, &types.QueryEvalRequest{PkgPath: pkgPath, Expr: expr})
+		require.NoError(t, err)
+		return res.Result
+	}
+
+	const (
+		pkgPath   = "gno.land/r/demo/notes"
+		purePath  = "gno.land/p/demo/format"
+		userPath  = "gno.land/r/demo/board"
+		notesCode = "package notes\n\nvar notes []string\n\nfunc Add(_ realm, note string) {\n\tnotes = append(notes, note)\n}\n\nfunc Count() int {\n\treturn len(notes)\n}\n"
+	)
+
+	addMsg := types.NewMsgAddPackage(creatorStr, nil, maxDeposit, newPackage("notes", pkgPath, map[string]string{"notes.gno": notesCode}))
+	addMsg.Admin = alice
+	_, err = ms.AddPackage(f.ctx, addMsg)
+	require.NoError(t, err)
+	_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, maxDeposit, pkgPath, "Add", []string{"hello"}))
+	require.NoError(t, err)
+
+	pkg, err := f.keeper.Packages.Get(f.ctx, pkgPath)
+	require.NoError(t, err)
+	require.Equal(t, alice, pkg.Admin)
+
+	t.Run("unknown package", func(t *testing.T) {
+		pkgBz := newPackage("unknown", "gno.land/r/demo/unknown", map[string]string{"unknown.gno": "package unknown\n"})
+		_, err := ms.UpgradePackage(f.ctx, types.NewMsgUpgradePackage(creatorStr, maxDeposit, pkgBz))
+		require.ErrorIs(t, err, types.ErrPackageNotFound)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		pkgBz := newPackage("notes", pkgPath, map[string]string{"notes.gno": notesCode})
+		_, err := ms.UpgradePackage(f.ctx, types.NewMsgUpgradePackage(bob, maxDeposit, pkgBz))
+		require.ErrorIs(t, err, types.ErrUnauthorizedUpgrade)
+	})
+
+	t.Run("variable type changed", func(t *testing.T) {
+		pkgBz := newPackage("notes", pkgPath, map[string]string{
+			"notes.gno": "package notes\n\nvar notes []int\n",
+		})
+		_, err := ms.UpgradePackage(f.ctx, types.NewMsgUpgradePackage(creatorStr, maxDeposit, pkgBz))
+		require.ErrorIs(t, err, types.ErrIncompatibleUpgrade)
+		require.ErrorContains(t, err, "variable notes changes type")
+	})
+
+	t.Run("variable removed", func(t *testing.T) {
+		pkgBz := newPackage("notes", pkgPath, map[string]string{
+			"notes.gno": "package notes\n\nfunc Count() int {\n\treturn 0\n}\n",
+		})
+		_, err := ms.UpgradePackage(f.ctx, types.NewMsgUpgradePackage(creatorStr, maxDeposit, pkgBz))
+		require.ErrorIs(t, err, types.ErrIncompatibleUpgrade)
+		require.ErrorContains(t, err, "variable notes is removed")
+	})
+
+	t.Run("creator upgrades the realm", func(t *testing.T) {
+		pkgBz := newPackage("notes", pkgPath, map[string]string{
+			"notes.gno": "package notes\n\nvar (\n\tnotes []string\n\tprefix = \"- \"\n)\n\nfunc Add(_ realm, note string) {\n\tnotes = append(notes, prefix+note)\n}\n\nfunc Count() int {\n\treturn len(notes)\n}\n\nfunc Last() string {\n\treturn notes[len(notes)-1]\n}\n",
+		})
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+		_, err := ms.UpgradePackage(ctx, types.NewMsgUpgradePackage(creatorStr, maxDeposit, pkgBz))
+		require.NoError(t, err)
+		require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(types.EventTypeUpgradePackage,
+			sdk.NewAttribute(types.AttributeKeyPkgPath, pkgPath),
+			sdk.NewAttribute(types.AttributeKeySender, creatorStr),
+		))
+
+		// the state is kept and the new code runs
+		require.Equal(t, "(1 int)", eval(pkgPath, "Count()"))
+		_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, maxDeposit, pkgPath, "Add", []string{"world"}))
+		require.NoError(t, err)
+		require.Equal(t, "(2 int)", eval(pkgPath, "Count()"))
+		require.Equal(t, `("- world" string)`, eval(pkgPath, "Last()"))
+	})
+
+	t.Run("admin upgrades the realm", func(t *testing.T) {
+		pkgBz := newPackage("notes", pkgPath, map[string]string{
+			"notes.gno": "package notes\n\nvar (\n\tnotes []string\n\tprefix = \"* \"\n)\n\nfunc Add(_ realm, note string) {\n\tnotes = append(notes, prefix+note)\n}\n\nfunc Count() int {\n\treturn len(notes)\n}\n\nfunc First() string {\n\treturn notes[0]\n}\n",
+		})
+		_, err := ms.UpgradePackage(f.ctx, types.NewMsgUpgradePackage(alice, maxDeposit, pkgBz))
+		require.NoError(t, err)
+
+		// the state of kept variables is kept, even when initialized
+		require.Equal(t, `("hello" string)`, eval(pkgPath, "First()"))
+		_, err = ms.Call(f.ctx, types.NewMsgCall(creatorStr, nil, maxDeposit, pkgPath, "Add", []string{"again"}))
+		require.NoError(t, err)
+		require.Equal(t, "(3 int)", eval(pkgPath, "Count()"))
+	})
+
+	t.Run("pure package with a dependent realm", func(t *testing.T) {
+		_, err := ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, newPackage("format", purePath, map[string]string{
+			"format.gno": "package format\n\nfunc Title(s string) string {\n\treturn \"# \" + s\n}\n",
+		})))
+		require.NoError(t, err)
+		_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, newPackage("board", userPath, map[string]string{
+			"board.gno": fmt.Sprintf("package board\n\nimport \"%s\"\n\nfunc Render(path string) string {\n\treturn format.Title(path)\n}\n", purePath),
+		})))
+		require.NoError(t, err)
+		require.Equal(t, `("# home" string)`, eval(userPath, `Render("home")`))
+
+		// the signature of an imported function cannot change without breaking
+		// the dependents
+		_, err = ms.UpgradePackage(f.ctx, types.NewMsgUpgradePackage(creatorStr, maxDeposit, newPackage("format", purePath, map[string]string{
+			"format.gno": "package format\n\nfunc Title(s string, level int) string {\n\treturn s\n}\n",
+		})))
+		require.Error(t, err)
+
+		_, err = ms.UpgradePackage(f.ctx, types.NewMsgUpgradePackage(creatorStr, maxDeposit, newPackage("format", purePath, map[string]string{
+			"format.gno": "package format\n\nfunc Title(s string) string {\n\treturn \"## \" + s\n}\n",
+		})))
+		require.NoError(t, err)
+		require.Equal(t, `("## home" string)`, eval(userPath, `Render("home")`))
+	})
+}
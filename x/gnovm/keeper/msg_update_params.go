@@ -26,7 +26,12 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 		req.Params.ChainDomain == "" &&
 		req.Params.DefaultDeposit == "" &&
 		req.Params.StoragePrice == "" &&
-		len(req.Params.StorageFeeCollector) == 0 {
+		len(req.Params.StorageFeeCollector) == 0 &&
+		len(req.Params.ReservedNamespaces) == 0 &&
+		req.Params.DeploymentPolicy == types.DeploymentPolicy_DEPLOYMENT_POLICY_OPEN &&
+		len(req.Params.DeploymentAllowlist) == 0 &&
+		len(req.Params.RestrictedDenoms) == 0 &&
+		len(req.Params.MintPermissions) == 0 {
 		return &types.MsgUpdateParamsResponse{}, nil
 	}
 
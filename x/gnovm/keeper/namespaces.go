@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// namespaceOwner returns the owner of the namespace, or an empty string when
+// the namespace is unclaimed. The reserved namespaces are owned by the module
+// authority, and the namespaces named after an address by that address.
+func (k *Keeper) namespaceOwner(ctx context.Context, params types.Params, name string) (string, error) {
+	if params.IsReservedNamespace(name) {
+		return k.addressCodec.BytesToString(k.authority)
+	}
+	if _, err := k.addressCodec.StringToBytes(name); err == nil {
+		return name, nil
+	}
+
+	ns, err := k.Namespaces.Get(ctx, name)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return ns.Owner, nil
+}
+
+// packageNamespace returns the namespace of the package path along with its
+// owner, see namespaceOwner. The namespace is empty for paths without one.
+func (k *Keeper) packageNamespace(ctx context.Context, pkgPath string) (name, owner string, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	name = types.PackageNamespace(params.ChainDomain, pkgPath)
+	if name == "" {
+		return "", "", nil
+	}
+	owner, err = k.namespaceOwner(ctx, params, name)
+
+	return name, owner, err
+}
+
+// checkNamespace checks that the creator owns the namespace of the package
+// path, and claims the namespace for the creator when it is unclaimed.
+func (k *Keeper) checkNamespace(ctx context.Context, pkgPath, creator string) error {
+	name, owner, err := k.packageNamespace(ctx, pkgPath)
+	if err != nil || name == "" {
+		return err
+	}
+
+	switch owner {
+	case creator:
+		return nil
+	case "":
+		return k.setNamespaceOwner(ctx, name, creator)
+	default:
+		return errorsmod.Wrapf(types.ErrUnauthorizedNamespace, "%s is not the owner of namespace %s", creator, name)
+	}
+}
+
+// setNamespaceOwner sets the owner of a namespace, or releases it when the
+// owner is empty.
+func (k *Keeper) setNamespaceOwner(ctx context.Context, name, owner string) error {
+	var err error
+	if owner == "" {
+		err = k.Namespaces.Remove(ctx, name)
+	} else {
+		err = k.Namespaces.Set(ctx, name, types.Namespace{Name: name, Owner: owner})
+	}
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(types.EventTypeNamespaceOwner,
+		sdk.NewAttribute(types.AttributeKeyNamespace, name),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner),
+	))
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// indexPackage records a deployed package in the packages index. The admin is
+// optional.
+func (k *Keeper) indexPackage(ctx context.Context, pkgPath, creator, admin string) error {
+	kind := types.PackageKind_PACKAGE_KIND_PURE
+	if gno.IsRealmPath(pkgPath) {
+		kind = types.PackageKind_PACKAGE_KIND_REALM
+	}
+
+	return k.Packages.Set(ctx, pkgPath, types.Package{
+		PkgPath: pkgPath,
+		Kind:    kind,
+		Creator: creator,
+		Admin:   admin,
+	})
+}
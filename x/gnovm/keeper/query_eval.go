@@ -28,11 +28,15 @@ func (q queryServer) Eval(ctx context.Context, req *types.QueryEvalRequest) (*ty
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize VM: %w", err)
 	}
+	defer q.k.readGnoCache(sdkCtx)()
 
 	result, err := q.k.VMKeeper.QueryEval(gnoCtx, req.PkgPath, req.Expr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to eval expression: %w", err)
 	}
 
-	return &types.QueryEvalResponse{Result: result}, nil
+	return &types.QueryEvalResponse{
+		Result:  result,
+		Results: types.TypedValuesFromGno(result),
+	}, nil
 }
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ignite/gnovm/x/gnovm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// File returns a source file of a deployed package.
+func (q queryServer) File(ctx context.Context, req *types.QueryFileRequest) (*types.QueryFileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.PkgPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "package path cannot be empty")
+	}
+
+	if req.Filename == "" || strings.Contains(req.Filename, "/") {
+		return nil, status.Error(codes.InvalidArgument, "invalid filename")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	gnoCtx, err := q.k.BuildGnoContext(sdkCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize VM: %w", err)
+	}
+	defer q.k.readGnoCache(sdkCtx)()
+
+	filepath := path.Join(req.PkgPath, req.Filename)
+	body, err := q.k.VMKeeper.QueryFile(gnoCtx, filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query file %s: %w", filepath, err)
+	}
+
+	return &types.QueryFileResponse{
+		File: types.PackageFile{Name: req.Filename, Body: body},
+	}, nil
+}
+
+// Files returns all the source files of a deployed package.
+func (q queryServer) Files(ctx context.Context, req *types.QueryFilesRequest) (*types.QueryFilesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.PkgPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "package path cannot be empty")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	gnoCtx, err := q.k.BuildGnoContext(sdkCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize VM: %w", err)
+	}
+	defer q.k.readGnoCache(sdkCtx)()
+
+	// querying a package path lists its filenames, one per line
+	names, err := q.k.VMKeeper.QueryFile(gnoCtx, req.PkgPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query files of %s: %w", req.PkgPath, err)
+	}
+
+	files := make([]types.PackageFile, 0)
+	for _, name := range strings.Split(names, "\n") {
+		if name == "" {
+			continue
+		}
+
+		body, err := q.k.VMKeeper.QueryFile(gnoCtx, path.Join(req.PkgPath, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query file %s: %w", name, err)
+		}
+		files = append(files, types.PackageFile{Name: name, Body: body})
+	}
+
+	return &types.QueryFilesResponse{Files: files}, nil
+}
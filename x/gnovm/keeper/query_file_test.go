@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/gnovm/x/gnovm/keeper"
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestFileQuery(t *testing.T) {
+	f := initFixture(t)
+	deployTestPackages(t, f)
+	q := keeper.NewQueryServerImpl(&f.keeper)
+
+	source, err := os.ReadFile(filepath.Join("testdata", "counter", "counter.gno"))
+	require.NoError(t, err)
+
+	res, err := q.File(f.ctx, &types.QueryFileRequest{PkgPath: "gno.land/r/demo/counter", Filename: "counter.gno"})
+	require.NoError(t, err)
+	require.Equal(t, types.PackageFile{Name: "counter.gno", Body: string(source)}, res.File)
+
+	_, err = q.File(f.ctx, &types.QueryFileRequest{PkgPath: "gno.land/r/demo/counter", Filename: "unknown.gno"})
+	require.ErrorContains(t, err, "gno.land/r/demo/counter/unknown.gno")
+
+	_, err = q.File(f.ctx, &types.QueryFileRequest{PkgPath: "gno.land/r/demo/counter"})
+	require.ErrorContains(t, err, "invalid filename")
+
+	_, err = q.File(f.ctx, &types.QueryFileRequest{Filename: "counter.gno"})
+	require.ErrorContains(t, err, "package path cannot be empty")
+}
+
+func TestFilesQuery(t *testing.T) {
+	f := initFixture(t)
+	deployTestPackages(t, f)
+	q := keeper.NewQueryServerImpl(&f.keeper)
+
+	res, err := q.Files(f.ctx, &types.QueryFilesRequest{PkgPath: "gno.land/p/demo/greet"})
+	require.NoError(t, err)
+	require.Len(t, res.Files, 2)
+	require.Equal(t, "gnomod.toml", res.Files[0].Name)
+	require.Equal(t, "greet.gno", res.Files[1].Name)
+	require.Contains(t, res.Files[1].Body, "func Hello()")
+
+	_, err = q.Files(f.ctx, &types.QueryFilesRequest{PkgPath: "gno.land/r/demo/unknown"})
+	require.ErrorContains(t, err, "gno.land/r/demo/unknown")
+
+	_, err = q.Files(f.ctx, &types.QueryFilesRequest{})
+	require.ErrorContains(t, err, "package path cannot be empty")
+}
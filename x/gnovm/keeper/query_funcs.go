@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ignite/gnovm/x/gnovm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Funcs returns the signatures of the exported functions of a realm.
+func (q queryServer) Funcs(ctx context.Context, req *types.QueryFuncsRequest) (*types.QueryFuncsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.PkgPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "package path cannot be empty")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	gnoCtx, err := q.k.BuildGnoContext(sdkCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize VM: %w", err)
+	}
+	defer q.k.readGnoCache(sdkCtx)()
+
+	fsigs, err := q.k.VMKeeper.QueryFuncs(gnoCtx, req.PkgPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query functions of %s: %w", req.PkgPath, err)
+	}
+
+	return &types.QueryFuncsResponse{
+		Functions: types.FunctionSignaturesFromGno(fsigs),
+	}, nil
+}
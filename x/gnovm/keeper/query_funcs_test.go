@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/gnovm/x/gnovm/keeper"
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestFuncsQuery(t *testing.T) {
+	f := initFixture(t)
+	deployTestPackages(t, f)
+	q := keeper.NewQueryServerImpl(&f.keeper)
+
+	res, err := q.Funcs(f.ctx, &types.QueryFuncsRequest{PkgPath: "gno.land/r/demo/counter"})
+	require.NoError(t, err)
+	require.Equal(t, []types.FunctionSignature{
+		{
+			Name:    "Increment",
+			Params:  []types.NamedType{{Name: "_", Type: types.RealmType}},
+			Results: []types.NamedType{{Name: "_", Type: "int"}},
+		},
+		{
+			Name:    "Render",
+			Params:  []types.NamedType{{Name: "_", Type: "string"}},
+			Results: []types.NamedType{{Name: "_", Type: "string"}},
+		},
+	}, res.Functions)
+
+	require.NoError(t, res.Functions[0].ValidateCallArgs(nil))
+
+	// only realms have callable functions
+	_, err = q.Funcs(f.ctx, &types.QueryFuncsRequest{PkgPath: "gno.land/p/demo/greet"})
+	require.ErrorContains(t, err, "gno.land/p/demo/greet")
+
+	_, err = q.Funcs(f.ctx, &types.QueryFuncsRequest{})
+	require.ErrorContains(t, err, "package path cannot be empty")
+}
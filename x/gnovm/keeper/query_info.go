@@ -27,6 +27,7 @@ func (q queryServer) Info(ctx context.Context, req *types.QueryInfoRequest) (*ty
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize VM: %w", err)
 	}
+	defer q.k.readGnoCache(sdkCtx)()
 
 	result, err := q.k.VMKeeper.QueryDoc(gnoCtx, req.PkgPath)
 	if err != nil {
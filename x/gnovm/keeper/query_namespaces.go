@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ignite/gnovm/x/gnovm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Namespace returns a claimed namespace.
+func (q queryServer) Namespace(ctx context.Context, req *types.QueryNamespaceRequest) (*types.QueryNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ns, err := q.k.Namespaces.Get(ctx, req.Name)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "namespace %s is not claimed", req.Name)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNamespaceResponse{Namespace: ns}, nil
+}
+
+// Namespaces lists the claimed namespaces, optionally filtered by owner.
+func (q queryServer) Namespaces(ctx context.Context, req *types.QueryNamespacesRequest) (*types.QueryNamespacesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Owner != "" {
+		if _, err := q.k.addressCodec.StringToBytes(req.Owner); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+		}
+	}
+
+	namespaces, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Namespaces,
+		req.Pagination,
+		func(_ string, ns types.Namespace) (bool, error) {
+			return req.Owner == "" || ns.Owner == req.Owner, nil
+		},
+		func(_ string, ns types.Namespace) (types.Namespace, error) {
+			return ns, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNamespacesResponse{Namespaces: namespaces, Pagination: pageRes}, nil
+}
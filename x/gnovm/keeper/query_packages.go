@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ignite/gnovm/x/gnovm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Packages lists the deployed packages matching the request filters.
+func (q queryServer) Packages(ctx context.Context, req *types.QueryPackagesRequest) (*types.QueryPackagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Creator != "" {
+		if _, err := q.k.addressCodec.StringToBytes(req.Creator); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err)
+		}
+	}
+
+	// the paths are stored as is, so the prefix is a range of the index
+	var opts []func(*query.CollectionsPaginateOptions[string])
+	if req.Prefix != "" {
+		opts = append(opts, func(o *query.CollectionsPaginateOptions[string]) {
+			o.Prefix = &req.Prefix
+		})
+	}
+
+	pkgs, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Packages,
+		req.Pagination,
+		func(_ string, pkg types.Package) (bool, error) {
+			if req.Kind != types.PackageKind_PACKAGE_KIND_UNSPECIFIED && pkg.Kind != req.Kind {
+				return false, nil
+			}
+			if req.Creator != "" && pkg.Creator != req.Creator {
+				return false, nil
+			}
+			return true, nil
+		},
+		func(_ string, pkg types.Package) (types.Package, error) {
+			return pkg, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPackagesResponse{Packages: pkgs, Pagination: pageRes}, nil
+}
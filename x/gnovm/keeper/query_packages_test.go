@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ignite/gnovm/x/gnovm/keeper"
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// deployTestPackages deploys the counter realm and a pure package, returning their creator.
+func deployTestPackages(t *testing.T, f *fixture) string {
+	t.Helper()
+
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	creatorBytes := f.keeper.GetAuthority()
+	creatorStr, err := f.addressCodec.BytesToString(creatorBytes)
+	require.NoError(t, err)
+
+	f.authKeeper.EXPECT().GetAccount(gomock.Any(), creatorBytes).
+		Return(authtypes.NewBaseAccountWithAddress(creatorBytes)).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), creatorBytes, gomock.Any(), gomock.Any()).AnyTimes()
+
+	counter, err := ReadMemPackageFromDir(filepath.Join("testdata", "counter"))
+	require.NoError(t, err)
+	greet, err := CreateMemPackageFromFiles("greet", "gno.land/p/demo/greet", map[string]string{
+		"greet.gno":   "package greet\n\nfunc Hello() string { return \"hello\" }\n",
+		"gnomod.toml": "module = \"gno.land/p/demo/greet\"\ngno = \"0.9\"\n",
+	})
+	require.NoError(t, err)
+	greet.Sort()
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("5000stake")
+	for _, mpkg := range []any{counter, greet} {
+		pkgBz, err := json.Marshal(mpkg)
+		require.NoError(t, err)
+
+		_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(creatorStr, nil, maxDeposit, pkgBz))
+		require.NoError(t, err)
+	}
+
+	return creatorStr
+}
+
+func TestPackagesQuery(t *testing.T) {
+	f := initFixture(t)
+	creator := deployTestPackages(t, f)
+	q := keeper.NewQueryServerImpl(&f.keeper)
+
+	otherCreator, err := f.addressCodec.BytesToString(authtypes.NewModuleAddress("other"))
+	require.NoError(t, err)
+
+	realm := types.Package{PkgPath: "gno.land/r/demo/counter", Kind: types.PackageKind_PACKAGE_KIND_REALM, Creator: creator}
+	pure := types.Package{PkgPath: "gno.land/p/demo/greet", Kind: types.PackageKind_PACKAGE_KIND_PURE, Creator: creator}
+
+	tests := []struct {
+		name     string
+		req      *types.QueryPackagesRequest
+		expected []types.Package
+		err      string
+	}{
+		{
+			name: "nil request",
+			err:  "invalid request",
+		},
+		{
+			name:     "all",
+			req:      &types.QueryPackagesRequest{},
+			expected: []types.Package{pure, realm},
+		},
+		{
+			name:     "prefix",
+			req:      &types.QueryPackagesRequest{Prefix: "gno.land/r/"},
+			expected: []types.Package{realm},
+		},
+		{
+			name:     "realms",
+			req:      &types.QueryPackagesRequest{Kind: types.PackageKind_PACKAGE_KIND_REALM},
+			expected: []types.Package{realm},
+		},
+		{
+			name:     "pure packages",
+			req:      &types.QueryPackagesRequest{Kind: types.PackageKind_PACKAGE_KIND_PURE},
+			expected: []types.Package{pure},
+		},
+		{
+			name:     "creator",
+			req:      &types.QueryPackagesRequest{Creator: creator},
+			expected: []types.Package{pure, realm},
+		},
+		{
+			name:     "other creator",
+			req:      &types.QueryPackagesRequest{Creator: otherCreator},
+			expected: []types.Package{},
+		},
+		{
+			name: "invalid creator",
+			req:  &types.QueryPackagesRequest{Creator: "invalid"},
+			err:  "invalid creator address",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := q.Packages(f.ctx, tc.req)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expected, res.Packages)
+		})
+	}
+}
+
+func TestPackagesQuery_Pagination(t *testing.T) {
+	f := initFixture(t)
+	deployTestPackages(t, f)
+	q := keeper.NewQueryServerImpl(&f.keeper)
+
+	res, err := q.Packages(f.ctx, &types.QueryPackagesRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Packages, 1)
+	require.Equal(t, "gno.land/p/demo/greet", res.Packages[0].PkgPath)
+	require.EqualValues(t, 2, res.Pagination.Total)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	res, err = q.Packages(f.ctx, &types.QueryPackagesRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Packages, 1)
+	require.Equal(t, "gno.land/r/demo/counter", res.Packages[0].PkgPath)
+	require.Empty(t, res.Pagination.NextKey)
+
+	// the pages of a prefix only hold the packages of the prefix
+	res, err = q.Packages(f.ctx, &types.QueryPackagesRequest{Prefix: "gno.land/", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Packages, 1)
+	require.Equal(t, "gno.land/p/demo/greet", res.Packages[0].PkgPath)
+	require.EqualValues(t, 2, res.Pagination.Total)
+
+	res, err = q.Packages(f.ctx, &types.QueryPackagesRequest{Prefix: "gno.land/", Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Packages, 1)
+	require.Equal(t, "gno.land/r/demo/counter", res.Packages[0].PkgPath)
+
+	res, err = q.Packages(f.ctx, &types.QueryPackagesRequest{Prefix: "gno.land/r/", Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Packages, 1)
+	require.EqualValues(t, 1, res.Pagination.Total)
+}
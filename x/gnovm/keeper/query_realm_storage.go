@@ -27,6 +27,7 @@ func (q queryServer) RealmStorage(ctx context.Context, req *types.QueryRealmStor
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize VM: %w", err)
 	}
+	defer q.k.readGnoCache(sdkCtx)()
 
 	result, err := q.k.VMKeeper.QueryStorage(gnoCtx, req.PkgPath)
 	if err != nil {
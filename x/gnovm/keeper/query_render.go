@@ -25,6 +25,7 @@ func (q queryServer) Render(ctx context.Context, req *types.QueryRenderRequest)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize VM: %w", err)
 	}
+	defer q.k.readGnoCache(sdkCtx)()
 
 	// Construct render expression
 	var expr string
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/ignite/gnovm/x/gnovm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StorageUsage returns the storage used by a realm, the deposit locked for it
+// and the deposit locked by each payer.
+func (q queryServer) StorageUsage(ctx context.Context, req *types.QueryStorageUsageRequest) (*types.QueryStorageUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.PkgPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "package path cannot be empty")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	denom, err := q.k.storageDepositDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	baseStore, iavlStore := q.k.gnoStores(sdkCtx)
+	gs := gno.NewStore(nil, baseStore, iavlStore)
+	rlm := gs.GetPackageRealm(req.PkgPath)
+	if rlm == nil {
+		return nil, status.Errorf(codes.NotFound, "realm %s not found", req.PkgPath)
+	}
+
+	resp := &types.QueryStorageUsageResponse{
+		Bytes:   rlm.Storage,
+		Deposit: sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromUint64(rlm.Deposit))),
+	}
+	err = q.k.StorageDeposits.Walk(ctx, collections.NewPrefixedPairRange[string, string](req.PkgPath), func(_ collections.Pair[string, string], deposit types.StorageDeposit) (bool, error) {
+		resp.Payers = append(resp.Payers, deposit)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// TotalStorageUsage returns the storage used by all the realms and the deposit
+// locked for them.
+func (q queryServer) TotalStorageUsage(ctx context.Context, req *types.QueryTotalStorageUsageRequest) (*types.QueryTotalStorageUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	denom, err := q.k.storageDepositDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	baseStore, iavlStore := q.k.gnoStores(sdkCtx)
+	gs := gno.NewStore(nil, baseStore, iavlStore)
+	var (
+		resp    types.QueryTotalStorageUsageResponse
+		deposit = math.ZeroInt()
+	)
+	err = q.k.Packages.Walk(ctx, nil, func(pkgPath string, pkg types.Package) (bool, error) {
+		if pkg.Kind != types.PackageKind_PACKAGE_KIND_REALM {
+			return false, nil
+		}
+		if rlm := gs.GetPackageRealm(pkgPath); rlm != nil {
+			resp.Bytes += rlm.Storage
+			deposit = deposit.Add(math.NewIntFromUint64(rlm.Deposit))
+			resp.Realms++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.Deposit = sdk.NewCoins(sdk.NewCoin(denom, deposit))
+
+	return &resp, nil
+}
+
+// storageDepositDenom returns the denom of the storage deposits, which is the
+// denom of the default deposit.
+func (k *Keeper) storageDepositDenom(ctx context.Context) (string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	deposit, err := sdk.ParseCoinNormalized(params.DefaultDeposit)
+	if err != nil {
+		return "", err
+	}
+
+	return deposit.Denom, nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ignite/gnovm/x/gnovm/keeper"
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestStorageUsageQuery(t *testing.T) {
+	f := initFixture(t)
+	creator := deployTestPackages(t, f)
+	q := keeper.NewQueryServerImpl(&f.keeper)
+
+	resp, err := q.StorageUsage(f.ctx, &types.QueryStorageUsageRequest{PkgPath: "gno.land/r/demo/counter"})
+	require.NoError(t, err)
+	require.NotZero(t, resp.Bytes)
+
+	// the deposit is the price of the storage used, paid by the creator
+	price, err := sdk.ParseCoinNormalized(types.DefaultParams().StoragePrice)
+	require.NoError(t, err)
+	deposit := sdk.NewCoins(sdk.NewCoin(price.Denom, price.Amount.Mul(math.NewIntFromUint64(resp.Bytes))))
+	require.Equal(t, deposit, resp.Deposit)
+	require.Equal(t, []types.StorageDeposit{{PkgPath: "gno.land/r/demo/counter", Payer: creator, Amount: deposit}}, resp.Payers)
+
+	// pure packages have no storage deposit
+	total, err := q.TotalStorageUsage(f.ctx, &types.QueryTotalStorageUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryTotalStorageUsageResponse{Bytes: resp.Bytes, Deposit: deposit, Realms: 1}, total)
+
+	_, err = q.StorageUsage(f.ctx, &types.QueryStorageUsageRequest{PkgPath: "gno.land/r/demo/unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = q.StorageUsage(f.ctx, &types.QueryStorageUsageRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestStorageUsageQuery_Payers ensures the deposits of the payers follow the
+// deposit of the realm when its storage is released by another account.
+func TestStorageUsageQuery_Payers(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+	q := keeper.NewQueryServerImpl(&f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.GenesisState{Params: types.DefaultParams()}))
+
+	aliceBytes := f.keeper.GetAuthority()
+	alice, err := f.addressCodec.BytesToString(aliceBytes)
+	require.NoError(t, err)
+	bobBytes := authtypes.NewModuleAddress("bob")
+	bob, err := f.addressCodec.BytesToString(bobBytes)
+	require.NoError(t, err)
+
+	for _, addr := range []sdk.AccAddress{aliceBytes, bobBytes} {
+		f.authKeeper.EXPECT().GetAccount(gomock.Any(), addr).
+			Return(authtypes.NewBaseAccountWithAddress(addr)).AnyTimes()
+	}
+	f.bankKeeper.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	const pkgPath = "gno.land/r/demo/notes"
+	mpkg, err := CreateMemPackageFromFiles("notes", pkgPath, map[string]string{
+		"gnomod.toml": "module = \"gno.land/r/demo/notes\"\ngno = \"0.9\"\n",
+		"notes.gno":   "package notes\n\nvar notes []string\n\nfunc Add(_ realm, note string) {\n\tnotes = append(notes, note)\n}\n\nfunc Clear(_ realm) {\n\tnotes = nil\n}\n",
+	})
+	require.NoError(t, err)
+	mpkg.Sort()
+	pkgBz, err := json.Marshal(mpkg)
+	require.NoError(t, err)
+
+	maxDeposit, _ := sdk.ParseCoinsNormalized("100000stake")
+	_, err = ms.AddPackage(f.ctx, types.NewMsgAddPackage(alice, nil, maxDeposit, pkgBz))
+	require.NoError(t, err)
+
+	_, err = ms.Call(f.ctx, types.NewMsgCall(bob, nil, maxDeposit, pkgPath, "Add", []string{strings.Repeat("b", 100)}))
+	require.NoError(t, err)
+	_, err = ms.Call(f.ctx, types.NewMsgCall(alice, nil, maxDeposit, pkgPath, "Add", []string{strings.Repeat("a", 200)}))
+	require.NoError(t, err)
+
+	added, err := q.StorageUsage(f.ctx, &types.QueryStorageUsageRequest{PkgPath: pkgPath})
+	require.NoError(t, err)
+	require.Len(t, added.Payers, 2)
+	requirePayersDeposit(t, added)
+
+	// bob releases more storage than he paid for, the rest is taken from alice
+	_, err = ms.Call(f.ctx, types.NewMsgCall(bob, nil, maxDeposit, pkgPath, "Clear", nil))
+	require.NoError(t, err)
+
+	cleared, err := q.StorageUsage(f.ctx, &types.QueryStorageUsageRequest{PkgPath: pkgPath})
+	require.NoError(t, err)
+	require.Less(t, cleared.Bytes, added.Bytes)
+	require.Len(t, cleared.Payers, 1)
+	require.Equal(t, alice, cleared.Payers[0].Payer)
+	requirePayersDeposit(t, cleared)
+}
+
+// requirePayersDeposit requires the deposits of the payers to add up to the
+// deposit of the realm.
+func requirePayersDeposit(t *testing.T, resp *types.QueryStorageUsageResponse) {
+	t.Helper()
+
+	var total sdk.Coins
+	for _, payer := range resp.Payers {
+		total = total.Add(payer.Amount...)
+	}
+	require.Equal(t, resp.Deposit, total)
+}
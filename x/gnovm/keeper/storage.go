@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	gnosdk "github.com/gnolang/gno/tm2/pkg/sdk"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// recordStorageDeposits records the storage deposits locked and unlocked by
+// the payer, from the storage events collected by the VM, and emits their
+// total. The VM always charges and refunds the caller of the message.
+func (k *Keeper) recordStorageDeposits(ctx context.Context, payer string, events []gnosdk.Event) error {
+	var locked, unlocked, refunded sdk.Coins
+	for _, event := range events {
+		switch evt := event.(type) {
+		case chain.StorageDepositEvent:
+			coin := sdk.NewInt64Coin(evt.FeeDelta.Denom, evt.FeeDelta.Amount)
+			if err := k.lockStorageDeposit(ctx, evt.PkgPath, payer, coin); err != nil {
+				return err
+			}
+			locked = locked.Add(coin)
+
+		case chain.StorageUnlockEvent:
+			coin := sdk.NewInt64Coin(evt.FeeRefund.Denom, evt.FeeRefund.Amount)
+			if err := k.unlockStorageDeposit(ctx, evt.PkgPath, payer, coin); err != nil {
+				return err
+			}
+			unlocked = unlocked.Add(coin)
+			if !evt.RefundWithheld {
+				refunded = refunded.Add(coin)
+			}
+		}
+	}
+
+	if locked.IsZero() && unlocked.IsZero() {
+		return nil
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(types.EventTypePayerStorageDeposit,
+		sdk.NewAttribute(types.AttributeKeyPayer, payer),
+		sdk.NewAttribute(types.AttributeKeyLocked, locked.String()),
+		sdk.NewAttribute(types.AttributeKeyUnlocked, unlocked.String()),
+		sdk.NewAttribute(types.AttributeKeyRefunded, refunded.String()),
+	))
+
+	return nil
+}
+
+// lockedDeposit returns the storage deposit of denom locked by the events.
+func lockedDeposit(events []gnosdk.Event, denom string) math.Int {
+	locked := math.ZeroInt()
+	for _, event := range events {
+		if evt, ok := event.(chain.StorageDepositEvent); ok && evt.FeeDelta.Denom == denom {
+			locked = locked.AddRaw(evt.FeeDelta.Amount)
+		}
+	}
+	return locked
+}
+
+// lockStorageDeposit adds the coin to the deposit of the payer for the realm.
+func (k *Keeper) lockStorageDeposit(ctx context.Context, pkgPath, payer string, coin sdk.Coin) error {
+	key := collections.Join(pkgPath, payer)
+	deposit, err := k.StorageDeposits.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	deposit.PkgPath = pkgPath
+	deposit.Payer = payer
+	deposit.Amount = deposit.Amount.Add(coin)
+
+	return k.StorageDeposits.Set(ctx, key, deposit)
+}
+
+// unlockStorageDeposit removes the coin from the deposits of the realm,
+// starting with the deposit of the payer unlocking it, then from the deposits
+// of the other payers in order.
+func (k *Keeper) unlockStorageDeposit(ctx context.Context, pkgPath, payer string, coin sdk.Coin) error {
+	remaining, err := k.unlockPayerDeposit(ctx, collections.Join(pkgPath, payer), coin.Denom, coin.Amount)
+	if err != nil || remaining.IsZero() {
+		return err
+	}
+
+	var keys []collections.Pair[string, string]
+	err = k.StorageDeposits.Walk(ctx, collections.NewPrefixedPairRange[string, string](pkgPath), func(key collections.Pair[string, string], _ types.StorageDeposit) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if remaining, err = k.unlockPayerDeposit(ctx, key, coin.Denom, remaining); err != nil || remaining.IsZero() {
+			return err
+		}
+	}
+
+	// the rest of the deposit was locked before the deposits were recorded
+	return nil
+}
+
+// unlockPayerDeposit removes up to amount from a deposit, and returns the
+// amount that is left to remove.
+func (k *Keeper) unlockPayerDeposit(ctx context.Context, key collections.Pair[string, string], denom string, amount math.Int) (math.Int, error) {
+	deposit, err := k.StorageDeposits.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return amount, nil
+	}
+	if err != nil {
+		return amount, err
+	}
+
+	unlocked := math.MinInt(deposit.Amount.AmountOf(denom), amount)
+	if unlocked.IsZero() {
+		return amount, nil
+	}
+
+	deposit.Amount = deposit.Amount.Sub(sdk.NewCoin(denom, unlocked))
+	if deposit.Amount.IsZero() {
+		return amount.Sub(unlocked), k.StorageDeposits.Remove(ctx, key)
+	}
+
+	return amount.Sub(unlocked), k.StorageDeposits.Set(ctx, key, deposit)
+}
@@ -0,0 +1,385 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	_ "unsafe" // for go:linkname
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/gnovm/pkg/gnomod"
+	"github.com/gnolang/gno/gnovm/pkg/packages"
+	gnostdlibs "github.com/gnolang/gno/gnovm/stdlibs"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	gnosdk "github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+// The VM cannot upgrade a package, and keeps the type check cache of the
+// transaction and the run of the declarations of files private. They are
+// linked here so that an upgrade checks and runs the new files as
+// VMKeeper.AddPackage does.
+
+//go:linkname getTypeCheckCache github.com/gnolang/gno/gno.land/pkg/sdk/vm.(*VMKeeper).getTypeCheckCache
+func getTypeCheckCache(vmk *vm.VMKeeper, ctx gnosdk.Context) gno.TypeCheckCache
+
+//go:linkname runFileDecls github.com/gnolang/gno/gnovm/pkg/gnolang.(*Machine).runFileDecls
+func runFileDecls(m *gno.Machine, withOverrides bool, fns ...*gno.FileNode) []gno.TypedValue
+
+// The gno store persists the source of the user packages under
+// "pkg:<package path>".
+const packageKeyPrefix = "pkg:"
+
+// upgradePackage replaces the code of a deployed package with the one of mpkg,
+// keeping the values of its variables, and so the state of the realm.
+//
+// The new package must keep the variables and the declared types of the
+// package with the same types, and the realm must not hold functions of the
+// package, as their code is replaced. The init functions are not run again,
+// and the initializers of the variables only set the new variables.
+//
+// The packages importing the package are preprocessed again against its new
+// code, as the VM does when it restarts, so an upgrade breaking them fails.
+func (k msgServer) upgradePackage(sdkCtx sdk.Context, gnoCtx gnosdk.Context, sender crypto.Address, mpkg *std.MemPackage, maxDeposit std.Coins) (err error) {
+	params := k.VMKeeper.GetParams(gnoCtx)
+	gnostore := getGnoTransactionStore(k.VMKeeper, gnoCtx)
+	pkgPath := mpkg.Path
+
+	mpkg.Type = gno.MPUserAll
+	if err := gno.ValidateMemPackageAny(mpkg); err != nil {
+		return vm.ErrInvalidPkgPath(err.Error())
+	}
+	oldMpkg := gnostore.GetMemPackage(pkgPath)
+	oldPv := gnostore.GetPackage(pkgPath, false)
+	if oldMpkg == nil || oldPv == nil {
+		return errorsmod.Wrapf(types.ErrPackageNotFound, "package %s not found", pkgPath)
+	}
+	if mpkg.Name != oldMpkg.Name {
+		return errorsmod.Wrapf(types.ErrIncompatibleUpgrade, "package name changes from %s to %s", oldMpkg.Name, mpkg.Name)
+	}
+
+	// Extra keeper-only checks, as for a new package.
+	gm, err := gnomod.ParseMemPackage(mpkg)
+	if err != nil {
+		return vm.ErrInvalidPackage(err.Error())
+	}
+	switch {
+	case gm.HasReplaces():
+		return vm.ErrInvalidPackage("development packages are not allowed")
+	case gm.Draft:
+		return vm.ErrInvalidPackage("draft packages can only be deployed at genesis time")
+	case gm.Private != oldPv.Private:
+		return vm.ErrInvalidPackage("an upgrade cannot change whether the package is private")
+	case mpkg.GetFile("gno.mod") != nil:
+		return vm.ErrInvalidPackage("gno.mod file is deprecated and not allowed, run 'gno mod tidy' to upgrade to gnomod.toml")
+	}
+	// The metadata of the deployment are kept.
+	if oldGm, err := gnomod.ParseMemPackage(oldMpkg); err == nil {
+		gm.AddPkg = oldGm.AddPkg
+	}
+	gm.Module = pkgPath
+	mpkg.SetFile("gnomod.toml", gm.WriteString())
+	mpkg.Sort()
+
+	dependents, deployedBefore := packageDependents(gnostore, pkgPath)
+	imports, err := packages.Imports(mpkg, nil)
+	if err != nil {
+		return vm.ErrInvalidPackage(err.Error())
+	}
+	// The VM preprocesses the packages in deployment order when it restarts.
+	for _, im := range imports.Merge(packages.FileKindPackageSource) {
+		if !gno.IsStdlib(im.PkgPath) && !deployedBefore[im.PkgPath] {
+			return errorsmod.Wrapf(types.ErrIncompatibleUpgrade, "package %s is not deployed before %s", im.PkgPath, pkgPath)
+		}
+	}
+
+	// The packages type checked against the old code are dropped from the cache.
+	tcCache := getTypeCheckCache(k.VMKeeper, gnoCtx)
+	delete(tcCache, pkgPath)
+	for _, dep := range dependents {
+		delete(tcCache, dep.Path)
+	}
+	// The test files are not type checked, as the VM keeps the getter of the
+	// test stdlibs private.
+	if _, err := gno.TypeCheckMemPackage(gno.MPFProd.FilterMemPackage(mpkg), gno.TypeCheckOptions{
+		Getter: gnostore,
+		Mode:   gno.TCLatestStrict,
+		Cache:  tcCache,
+	}); err != nil {
+		return vm.ErrTypeCheck(err)
+	}
+
+	if err := k.checkStoredFuncs(sdkCtx, pkgPath); err != nil {
+		return err
+	}
+
+	msgCtx := gnostdlibs.ExecContext{
+		ChainID:         gnoCtx.ChainID(),
+		ChainDomain:     params.ChainDomain,
+		Height:          gnoCtx.BlockHeight(),
+		Timestamp:       gnoCtx.BlockTime().Unix(),
+		OriginCaller:    sender.Bech32(),
+		OriginSendSpent: new(std.Coins),
+		Banker:          vm.NewSDKBanker(k.VMKeeper, gnoCtx),
+		Params:          vm.NewSDKParams(k.vmParams, gnoCtx),
+		EventLogger:     gnoCtx.EventLogger(),
+	}
+	m := gno.NewMachineWithOptions(
+		gno.MachineOptions{
+			PkgPath:  "",
+			Output:   k.VMKeeper.Output,
+			Store:    gnostore,
+			Alloc:    gnostore.GetAllocator(),
+			Context:  msgCtx,
+			GasMeter: gnoCtx.GasMeter(),
+		})
+	defer m.Release()
+	defer doRecover(m, &err)
+
+	if err := upgradeMemPackage(m, oldPv, mpkg); err != nil {
+		return err
+	}
+	for _, dep := range dependents {
+		preprocessMemPackage(m, dep)
+	}
+
+	// The source is replaced in place, so that the package keeps its place
+	// in the deployment order.
+	_, iavlStore := k.gnoStores(sdkCtx)
+	iavlStore.Set([]byte(packageKeyPrefix+pkgPath), amino.MustMarshal(mpkg))
+
+	return processStorageDeposit(k.VMKeeper, gnoCtx, sender, maxDeposit, gnostore, params)
+}
+
+// upgradeMemPackage runs the declarations of the new package and moves them,
+// along with the variables of the old package, to the package value.
+func upgradeMemPackage(m *gno.Machine, oldPv *gno.PackageValue, mpkg *std.MemPackage) error {
+	store := m.Store
+	oldPn := store.GetPackageNode(mpkg.Path)
+	rlm := oldPv.GetRealm()
+	if rlm == nil {
+		rlm = store.GetPackageRealm(mpkg.Path)
+	}
+	if rlm == nil {
+		return fmt.Errorf("missing realm record of package %s", mpkg.Path)
+	}
+
+	files := m.ParseMemPackageAsType(mpkg, gno.MPUserProd)
+	pn := gno.NewPackageNode(gno.Name(mpkg.Name), mpkg.Path, &gno.FileSet{})
+	store.SetBlockNode(pn)
+	pv := pn.NewPackage(m.Alloc)
+	m.SetActivePackage(pv)
+	runFileDecls(m, false, files.Files...)
+
+	oldVars, oldTypes := packageDecls(oldPn)
+	newVars, newTypes := packageDecls(pn)
+	for _, name := range oldVars {
+		if !slices.Contains(newVars, name) {
+			return errorsmod.Wrapf(types.ErrIncompatibleUpgrade, "variable %s is removed", name)
+		}
+		oldT, newT := oldPn.GetStaticTypeOf(store, name), pn.GetStaticTypeOf(store, name)
+		if oldT.TypeID() != newT.TypeID() {
+			return errorsmod.Wrapf(types.ErrIncompatibleUpgrade, "variable %s changes type from %s to %s", name, oldT, newT)
+		}
+	}
+	for _, name := range oldTypes {
+		if !slices.Contains(newTypes, name) {
+			return errorsmod.Wrapf(types.ErrIncompatibleUpgrade, "type %s is removed", name)
+		}
+		oldT, newT := declaredType(store, oldPn, name), declaredType(store, pn, name)
+		if oldT.Base.TypeID() != newT.Base.TypeID() {
+			return errorsmod.Wrapf(types.ErrIncompatibleUpgrade, "type %s changes from %s to %s", name, oldT.Base, newT.Base)
+		}
+	}
+
+	// The declared types are saved before the old package block is loaded,
+	// as the store keeps the first type it loads for a type ID.
+	for _, name := range newTypes {
+		dt := declaredType(store, pn, name)
+		store.SetType(dt)
+		if store.GetType(dt.TypeID()) != dt {
+			return fmt.Errorf("type %s of package %s is already loaded", name, mpkg.Path)
+		}
+	}
+
+	// The package block takes the new values, but the variables of the old
+	// package.
+	oldPb := oldPv.GetBlock(store)
+	values := slices.Clone(pv.GetBlock(store).Values)
+	kept, oldKept := make(map[uint16]bool), make(map[uint16]bool)
+	for _, name := range oldVars {
+		oldIdx, _ := oldPn.GetLocalIndex(name)
+		idx, _ := pn.GetLocalIndex(name)
+		values[idx] = oldPb.Values[oldIdx]
+		kept[idx], oldKept[oldIdx] = true, true
+	}
+	for i, tv := range oldPb.Values {
+		if oo := valueObject(store, tv); oo != nil && !oldKept[uint16(i)] {
+			rlm.DidUpdate(oldPb, oo, nil)
+		}
+	}
+	for i, tv := range values {
+		if oo := valueObject(store, tv); oo != nil && !kept[uint16(i)] {
+			rlm.DidUpdate(oldPb, nil, oo)
+		}
+	}
+	oldPb.Values = values
+	oldPb.Source = pn
+
+	// The file blocks are replaced, their values are the imported packages,
+	// which are not owned by the package.
+	for _, fbv := range oldPv.FBlocks {
+		fb := valueObject(store, gno.TypedValue{V: fbv}).(*gno.Block)
+		fb.Values = nil
+		rlm.DidUpdate(oldPv, fb, nil)
+	}
+	oldPv.FNames, oldPv.FBlocks = nil, nil
+	for i, fname := range pv.FNames {
+		fb := pv.FBlocks[i].(*gno.Block)
+		fb.Parent = oldPb
+		oldPv.AddFileBlock(fname, fb)
+		rlm.DidUpdate(oldPv, nil, fb)
+	}
+
+	rlm.FinalizeRealmTransaction(store)
+	store.SetPackageRealm(rlm)
+
+	return nil
+}
+
+// preprocessMemPackage preprocesses the package again and saves its nodes, as
+// the VM does for all the packages when it restarts.
+func preprocessMemPackage(m *gno.Machine, mpkg *std.MemPackage) {
+	mpkg = gno.MPFProd.FilterMemPackage(mpkg)
+	fset := m.ParseMemPackage(mpkg)
+	pn := gno.NewPackageNode(gno.Name(mpkg.Name), mpkg.Path, fset)
+	m.Store.SetBlockNode(pn)
+	gno.PredefineFileSet(m.Store, pn, fset)
+	for _, fn := range fset.Files {
+		fn = gno.Preprocess(m.Store, pn, fn).(*gno.FileNode)
+		gno.SaveBlockNodes(m.Store, fn)
+	}
+	if pn.FileSet == nil {
+		pn.FileSet = fset
+	}
+}
+
+// packageDependents returns the packages importing the package, directly or
+// not, in deployment order, and the packages deployed before the package.
+func packageDependents(store gno.Store, pkgPath string) (dependents []*std.MemPackage, deployedBefore map[string]bool) {
+	deployedBefore = make(map[string]bool)
+	upgraded := map[string]bool{pkgPath: true}
+	found := false
+	// the channel must be drained, so the packages are only collected
+	for mpkg := range store.IterMemPackage() {
+		switch {
+		case mpkg.Path == pkgPath:
+			found = true
+			continue
+		case !found:
+			deployedBefore[mpkg.Path] = true
+			continue
+		case upgraded[mpkg.Path]:
+			continue
+		}
+
+		imports, err := packages.Imports(mpkg, nil)
+		if err != nil {
+			continue
+		}
+		for _, im := range imports.Merge(packages.FileKindPackageSource) {
+			if upgraded[im.PkgPath] {
+				upgraded[mpkg.Path] = true
+				dependents = append(dependents, mpkg)
+				break
+			}
+		}
+	}
+
+	return dependents, deployedBefore
+}
+
+// packageDecls returns the names of the variables and of the declared types
+// of the package.
+func packageDecls(pn *gno.PackageNode) (vars, declTypes []gno.Name) {
+	for _, fn := range pn.FileSet.Files {
+		for _, decl := range fn.Decls {
+			switch decl := decl.(type) {
+			case *gno.ValueDecl:
+				if !decl.Const {
+					vars = append(vars, decl.GetDeclNames()...)
+				}
+			case *gno.TypeDecl:
+				if !decl.IsAlias {
+					declTypes = append(declTypes, decl.GetDeclNames()...)
+				}
+			}
+		}
+	}
+
+	return vars, declTypes
+}
+
+// declaredType returns the declared type of the package with the name.
+func declaredType(store gno.Store, pn *gno.PackageNode, name gno.Name) *gno.DeclaredType {
+	return pn.GetSlot(store, name, true).V.(gno.TypeValue).Type.(*gno.DeclaredType)
+}
+
+// valueObject returns the object of the value, if any, loading it from the
+// store if needed.
+func valueObject(store gno.Store, tv gno.TypedValue) gno.Object {
+	switch v := tv.V.(type) {
+	case gno.RefValue:
+		return store.GetObject(v.ObjectID)
+	case gno.Object:
+		return v
+	default:
+		return nil
+	}
+}
+
+// checkStoredFuncs checks that the realm holds no function of the package
+// other than the functions it declares, as their code would be replaced.
+func (k *Keeper) checkStoredFuncs(sdkCtx sdk.Context, pkgPath string) error {
+	baseStore, _ := k.gnoStores(sdkCtx)
+	prefix := []byte(objectKeyPrefix + hex.EncodeToString(gno.PkgIDFromPkgPath(pkgPath).Bytes()) + ":")
+	iter := baseStore.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if bytes.HasSuffix(iter.Key(), []byte(realmKeySuffix)) {
+			continue
+		}
+
+		var oo gno.Object
+		if err := amino.Unmarshal(iter.Value()[gno.HashSize:], &oo); err != nil {
+			return fmt.Errorf("failed to decode object %s: %w", iter.Key(), err)
+		}
+
+		var fv *gno.FuncValue
+		switch oo := oo.(type) {
+		case *gno.FuncValue:
+			// the declared functions are referenced by the package block only
+			if !oo.IsClosure && oo.GetRefCount() == 1 {
+				continue
+			}
+			fv = oo
+		case *gno.BoundMethodValue:
+			fv = oo.Func
+		}
+		if fv != nil && fv.PkgPath == pkgPath {
+			return errorsmod.Wrapf(types.ErrIncompatibleUpgrade, "the realm holds the function %s of the package", fv.Name)
+		}
+	}
+
+	return nil
+}
@@ -3,9 +3,13 @@ package keeper
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
 	"github.com/gnolang/gno/tm2/pkg/crypto"
 	gnosdk "github.com/gnolang/gno/tm2/pkg/sdk"
@@ -17,19 +21,25 @@ import (
 
 var _ vm.AccountKeeperI = (*vmAuthKeeper)(nil)
 
+// sdkContext returns the SDK context carried by the gno context.
+// The gno contexts are built by Keeper.BuildGnoContext, which attaches the SDK
+// context of the current call path, so each call is isolated from the others.
+func sdkContext(ctx gnosdk.Context) sdk.Context {
+	return sdk.UnwrapSDKContext(ctx.Context())
+}
+
 // vmAuthKeeper is a wrapper of the Cosmos SDK auth keeper to the VM expected auth keeper.
 type vmAuthKeeper struct {
 	logger     log.Logger
 	authKeeper types.AuthKeeper
 	bankKeeper types.BankKeeper
-
-	vmParams *vmKeeperParams
 }
 
 // GetAccount implements vm.AccountKeeperI.
 func (v vmAuthKeeper) GetAccount(ctx gnosdk.Context, addr crypto.Address) std.Account {
-	account := v.authKeeper.GetAccount(v.vmParams.sdkCtx, addr.Bytes())
-	return types.StdAccountFromSDKAccount(v.vmParams.sdkCtx, account, v.bankKeeper)
+	sdkCtx := sdkContext(ctx)
+	account := v.authKeeper.GetAccount(sdkCtx, addr.Bytes())
+	return types.StdAccountFromSDKAccount(sdkCtx, account, v.bankKeeper)
 }
 
 var _ vm.BankKeeperI = (*vmBankKeeper)(nil)
@@ -38,83 +48,194 @@ var _ vm.BankKeeperI = (*vmBankKeeper)(nil)
 type vmBankKeeper struct {
 	logger     log.Logger
 	bankKeeper types.BankKeeper
-
-	vmParams *vmKeeperParams
+	params     collections.Item[types.Params]
 }
 
 // RestrictedDenoms implements vm.BankKeeperI.
+// The VM withholds the storage deposit refunds in a restricted denom, which
+// are sent to the storage fee collector.
 func (v vmBankKeeper) RestrictedDenoms(ctx gnosdk.Context) []string {
-	return []string{}
+	params, err := v.params.Get(sdkContext(ctx))
+	if err != nil {
+		v.logger.Error("failed to get restricted denoms", "error", err)
+		return []string{}
+	}
+
+	return params.RestrictedDenoms
 }
 
 // AddCoins implements vm.BankKeeperI.
 func (v vmBankKeeper) AddCoins(ctx gnosdk.Context, addr crypto.Address, amt std.Coins) (std.Coins, error) {
-	addedCoins := types.SDKCoinsFromStdCoins(amt)
+	sdkCtx := sdkContext(ctx)
+	addedCoins, err := types.SDKCoinsFromStdCoins(amt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.checkMint(sdkCtx, addedCoins); err != nil {
+		return nil, err
+	}
+	// the minted coins are sent to the account as with a bank transfer
+	if err := v.checkTransfer(sdkCtx, addr.Bytes(), addedCoins); err != nil {
+		return nil, err
+	}
 
 	// mint coins to the module
-	if err := v.bankKeeper.MintCoins(v.vmParams.sdkCtx, types.ModuleName, addedCoins); err != nil {
+	if err := v.bankKeeper.MintCoins(sdkCtx, types.ModuleName, addedCoins); err != nil {
 		return nil, err
 	}
 
 	// send minted coins from module to account
-	if err := v.bankKeeper.SendCoinsFromModuleToAccount(v.vmParams.sdkCtx, types.ModuleName, addr.Bytes(), addedCoins); err != nil {
+	if err := v.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, addr.Bytes(), addedCoins); err != nil {
 		return nil, err
 	}
 
+	// make the denoms issued by realms visible to wallets
+	v.registerDenomMetadata(sdkCtx, addedCoins)
+
 	// get and return new balance
-	newBalances := v.bankKeeper.GetAllBalances(v.vmParams.sdkCtx, addr.Bytes())
-	return types.StdCoinsFromSDKCoins(newBalances), nil
+	newBalances := v.bankKeeper.GetAllBalances(sdkCtx, addr.Bytes())
+	return types.CappedStdCoinsFromSDKCoins(newBalances), nil
 }
 
 // GetCoins implements vm.BankKeeperI.
 func (v vmBankKeeper) GetCoins(ctx gnosdk.Context, addr crypto.Address) std.Coins {
-	coins := v.bankKeeper.GetAllBalances(v.vmParams.sdkCtx, addr.Bytes())
-	return types.StdCoinsFromSDKCoins(coins)
+	coins := v.bankKeeper.GetAllBalances(sdkContext(ctx), addr.Bytes())
+	return types.CappedStdCoinsFromSDKCoins(coins)
 }
 
 // SendCoins implements vm.BankKeeperI.
+// It is used for the coins sent by realms and to them, which cannot be in a
+// restricted denom and are subject to the same policies as a bank transfer.
 func (v vmBankKeeper) SendCoins(ctx gnosdk.Context, fromAddr crypto.Address, toAddr crypto.Address, amt std.Coins) error {
-	return v.bankKeeper.SendCoins(
-		v.vmParams.sdkCtx,
-		fromAddr.Bytes(),
-		toAddr.Bytes(),
-		types.SDKCoinsFromStdCoins(amt),
-	)
+	if err := checkRestrictedDenoms(v.RestrictedDenoms(ctx), amt); err != nil {
+		return err
+	}
+
+	sdkCtx := sdkContext(ctx)
+	coins, err := types.SDKCoinsFromStdCoins(amt)
+	if err != nil {
+		return err
+	}
+	if err := v.checkTransfer(sdkCtx, toAddr.Bytes(), coins); err != nil {
+		return err
+	}
+
+	return v.bankKeeper.SendCoins(sdkCtx, fromAddr.Bytes(), toAddr.Bytes(), coins)
 }
 
 // SendCoinsUnrestricted implements vm.BankKeeperI.
+// It is only used by the VM to lock and refund the storage deposits, which can
+// be in a restricted denom or in a denom whose transfers are disabled, and can
+// be refunded to the storage fee collector even if it is a blocked address.
+// The send restrictions of the bank still apply, as they are enforced by the
+// bank keeper on every transfer.
 func (v vmBankKeeper) SendCoinsUnrestricted(ctx gnosdk.Context, fromAddr crypto.Address, toAddr crypto.Address, amt std.Coins) error {
-	return v.bankKeeper.SendCoins(
-		v.vmParams.sdkCtx,
-		fromAddr.Bytes(),
-		toAddr.Bytes(),
-		types.SDKCoinsFromStdCoins(amt),
-	)
+	coins, err := types.SDKCoinsFromStdCoins(amt)
+	if err != nil {
+		return err
+	}
+
+	return v.bankKeeper.SendCoins(sdkContext(ctx), fromAddr.Bytes(), toAddr.Bytes(), coins)
+}
+
+// checkMint returns an error if the coins cannot be issued, i.e. if one of
+// their denoms has no mint permission, or if its supply would exceed the cap
+// of its permission.
+// The VM only lets realms issue the denoms prefixed by their path, which the
+// permissions are checked against.
+func (v vmBankKeeper) checkMint(ctx sdk.Context, coins sdk.Coins) error {
+	params, err := v.params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		idx := slices.IndexFunc(params.MintPermissions, func(perm types.MintPermission) bool { return perm.Denom == coin.Denom })
+		if idx < 0 {
+			return errorsmod.Wrapf(types.ErrUnauthorizedMint, "%s has no mint permission", coin.Denom)
+		}
+
+		perm := params.MintPermissions[idx]
+		if !perm.HasSupplyCap() {
+			continue
+		}
+		if supply := v.bankKeeper.GetSupply(ctx, coin.Denom); supply.Amount.Add(coin.Amount).GT(perm.SupplyCap) {
+			return errorsmod.Wrapf(types.ErrSupplyCapExceeded, "supply of %s would exceed its cap of %s", coin.Denom, perm.SupplyCap)
+		}
+	}
+
+	return nil
+}
+
+// registerDenomMetadata registers the bank metadata of the denoms issued by
+// realms, when they are first issued.
+func (v vmBankKeeper) registerDenomMetadata(ctx sdk.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		pkgPath, symbol, ok := types.ParseRealmDenom(coin.Denom)
+		if !ok || v.bankKeeper.HasDenomMetaData(ctx, coin.Denom) {
+			continue
+		}
+		v.bankKeeper.SetDenomMetaData(ctx, types.RealmDenomMetadata(pkgPath, symbol))
+	}
+}
+
+// checkTransfer applies the checks of a bank transfer which the bank keeper
+// leaves to the bank messages: the denoms must be sendable and the recipient
+// must not be a blocked address, e.g. a module account.
+func (v vmBankKeeper) checkTransfer(ctx sdk.Context, toAddr sdk.AccAddress, coins sdk.Coins) error {
+	if coins.IsZero() {
+		return nil
+	}
+
+	if err := v.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+		return err
+	}
+	if v.bankKeeper.BlockedAddr(toAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
+	}
+
+	return nil
 }
 
 // SubtractCoins implements vm.BankKeeperI.
 func (v vmBankKeeper) SubtractCoins(ctx gnosdk.Context, addr crypto.Address, amt std.Coins) (std.Coins, error) {
-	balances := v.bankKeeper.GetAllBalances(v.vmParams.sdkCtx, addr.Bytes())
+	sdkCtx := sdkContext(ctx)
+	balances := v.bankKeeper.GetAllBalances(sdkCtx, addr.Bytes())
+
+	sentCoins, err := types.SDKCoinsFromStdCoins(amt)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, addr.Bytes(), types.ModuleName, sentCoins); err != nil {
+		return nil, err
+	}
 
-	sentCoins := types.SDKCoinsFromStdCoins(amt)
-	if err := v.bankKeeper.SendCoinsFromAccountToModule(v.vmParams.sdkCtx, addr.Bytes(), types.ModuleName, sentCoins); err != nil {
+	// the coins removed by realms are burned, so that they leave the supply
+	if err := v.bankKeeper.BurnCoins(sdkCtx, types.ModuleName, sentCoins); err != nil {
 		return nil, err
 	}
 
 	subBalances := balances.Sub(sentCoins...)
-	return types.StdCoinsFromSDKCoins(subBalances), nil
+	return types.CappedStdCoinsFromSDKCoins(subBalances), nil
 }
 
-var _ vm.ParamsKeeperI = (*vmKeeperParams)(nil)
+// checkRestrictedDenoms returns an error if the coins contain one of the
+// restricted denoms.
+func checkRestrictedDenoms(restrictedDenoms []string, amt std.Coins) error {
+	for _, coin := range amt {
+		if slices.Contains(restrictedDenoms, coin.Denom) {
+			return errorsmod.Wrapf(types.ErrRestrictedDenom, "%s cannot be transferred through the VM", coin.Denom)
+		}
+	}
 
-type vmKeeperParams struct {
-	k      *Keeper
-	sdkCtx sdk.Context
+	return nil
 }
 
-// SetSDKContext sets the SDK context for store operations
-func (k *vmKeeperParams) SetSDKContext(ctx sdk.Context) {
-	k.sdkCtx = ctx
+var _ vm.ParamsKeeperI = (*vmKeeperParams)(nil)
+
+type vmKeeperParams struct {
+	k *Keeper
 }
 
 // paramStoreKey generates the store key for a given parameter
@@ -128,7 +249,7 @@ func (k *vmKeeperParams) GetAny(ctx gnosdk.Context, key string) any {
 	data := k.GetRaw(ctx, key)
 	if len(data) == 0 {
 		// fallback to module params when not found in raw store
-		if params, err := k.k.Params.Get(k.sdkCtx); err == nil {
+		if params, err := k.k.Params.Get(sdkContext(ctx)); err == nil {
 			switch key {
 			case "vm:p:sysnames_pkgpath":
 				return params.SysnamesPkgpath
@@ -184,7 +305,7 @@ func (k *vmKeeperParams) GetBytes(ctx gnosdk.Context, key string, ptr *[]byte) {
 	if len(data) == 0 {
 		// fallback to module params for known byte keys
 		if key == "vm:p:storage_fee_collector" {
-			if params, err := k.k.Params.Get(k.sdkCtx); err == nil {
+			if params, err := k.k.Params.Get(sdkContext(ctx)); err == nil {
 				*ptr = append([]byte(nil), params.StorageFeeCollector...)
 				return
 			}
@@ -220,7 +341,7 @@ func (k *vmKeeperParams) GetInt64(ctx gnosdk.Context, key string, ptr *int64) {
 // GetRaw implements vm.ParamsKeeperI.
 func (k *vmKeeperParams) GetRaw(ctx gnosdk.Context, key string) []byte {
 	// use store service to get the value
-	store := k.k.storeService.OpenKVStore(k.sdkCtx)
+	store := k.k.storeService.OpenKVStore(sdkContext(ctx))
 	storeKey := []byte(k.paramStoreKey(key))
 
 	data, err := store.Get(storeKey)
@@ -238,7 +359,7 @@ func (k *vmKeeperParams) GetString(ctx gnosdk.Context, key string, ptr *string)
 	data := k.GetRaw(ctx, key)
 	if len(data) == 0 {
 		// fallback to module params for known string keys
-		if params, err := k.k.Params.Get(k.sdkCtx); err == nil {
+		if params, err := k.k.Params.Get(sdkContext(ctx)); err == nil {
 			switch key {
 			case "vm:p:sysnames_pkgpath":
 				*ptr = params.SysnamesPkgpath
@@ -331,7 +452,7 @@ func (k *vmKeeperParams) GetUint64(ctx gnosdk.Context, key string, ptr *uint64)
 // Has implements vm.ParamsKeeperI.
 func (k *vmKeeperParams) Has(ctx gnosdk.Context, key string) bool {
 	// use store service to check if the key exists
-	store := k.k.storeService.OpenKVStore(k.sdkCtx)
+	store := k.k.storeService.OpenKVStore(sdkContext(ctx))
 	storeKey := []byte(k.paramStoreKey(key))
 
 	has, err := store.Has(storeKey)
@@ -391,7 +512,7 @@ func (k *vmKeeperParams) SetInt64(ctx gnosdk.Context, key string, value int64) {
 // SetRaw implements vm.ParamsKeeperI.
 func (k *vmKeeperParams) SetRaw(ctx gnosdk.Context, key string, value []byte) {
 	// use store service to set the value
-	store := k.k.storeService.OpenKVStore(k.sdkCtx)
+	store := k.k.storeService.OpenKVStore(sdkContext(ctx))
 	storeKey := []byte(k.paramStoreKey(key))
 
 	if err := store.Set(storeKey, value); err != nil {
@@ -48,6 +48,59 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 				},
 
+				{
+					RpcMethod: "Packages",
+					Use:       "packages",
+					Short:     "Lists the deployed packages and realms.",
+				},
+
+				{
+					RpcMethod:      "File",
+					Use:            "file [pkg-path] [filename]",
+					Short:          "Query a source file of a package.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pkg_path"}, {ProtoField: "filename"}},
+				},
+
+				{
+					RpcMethod:      "Files",
+					Use:            "files [pkg-path]",
+					Short:          "Query all the source files of a package.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pkg_path"}},
+				},
+
+				{
+					RpcMethod:      "Funcs",
+					Use:            "funcs [pkg-path]",
+					Short:          "Query the signatures of the exported functions of a realm.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pkg_path"}},
+				},
+
+				{
+					RpcMethod:      "Namespace",
+					Use:            "namespace [name]",
+					Short:          "Query the owner of a namespace.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+
+				{
+					RpcMethod: "Namespaces",
+					Use:       "namespaces",
+					Short:     "Lists the claimed namespaces.",
+				},
+
+				{
+					RpcMethod:      "StorageUsage",
+					Use:            "storage-usage [pkg-path]",
+					Short:          "Query the storage used by a realm and the deposit locked for it.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pkg_path"}},
+				},
+
+				{
+					RpcMethod: "TotalStorageUsage",
+					Use:       "total-storage-usage",
+					Short:     "Query the storage used by all the realms and the deposit locked for them.",
+				},
+
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -59,6 +112,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:   "UpdateParams",
 					GovProposal: true,
 				},
+				{
+					// packages are added with add-package --recursive
+					RpcMethod: "AddPackages",
+					Skip:      true,
+				},
+				{
+					RpcMethod:      "ClaimNamespace",
+					Use:            "claim-namespace [namespace]",
+					Short:          "Claim a namespace of package paths.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod:      "TransferNamespace",
+					Use:            "transfer-namespace [namespace] [new-owner]",
+					Short:          "Transfer a namespace to a new owner.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "ReleaseNamespace",
+					Use:            "release-namespace [namespace]",
+					Short:          "Release a namespace so that it can be claimed again.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -8,7 +8,9 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/spf13/cast"
 
 	"github.com/ignite/gnovm/x/gnovm/keeper"
 	"github.com/ignite/gnovm/x/gnovm/types"
@@ -35,6 +37,7 @@ type ModuleInputs struct {
 	MemStoreKey  *storetypes.MemoryStoreKey
 	Cdc          codec.Codec
 	AddressCodec address.Codec
+	AppOpts      servertypes.AppOptions `optional:"true"`
 
 	AuthKeeper types.AuthKeeper
 	BankKeeper types.BankKeeper
@@ -63,6 +66,13 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AuthKeeper,
 		in.BankKeeper,
 	)
+	if in.AppOpts != nil {
+		exportFormat, err := types.ParseExportFormat(cast.ToString(in.AppOpts.Get(types.FlagExportFormat)))
+		if err != nil {
+			panic(err)
+		}
+		k.SetExportFormat(exportFormat)
+	}
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
 	return ModuleOutputs{GnoVMKeeper: k, Module: m}
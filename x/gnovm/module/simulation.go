@@ -44,6 +44,21 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgAddPackage,
 		gnovmsimulation.SimulateMsgAddPackage(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
+	const (
+		opWeightMsgAddPackages          = "op_weight_msg_add_packages"
+		defaultWeightMsgAddPackages int = 100
+	)
+
+	var weightMsgAddPackages int
+	simState.AppParams.GetOrGenerate(opWeightMsgAddPackages, &weightMsgAddPackages, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddPackages = defaultWeightMsgAddPackages
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAddPackages,
+		gnovmsimulation.SimulateMsgAddPackages(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
 	const (
 		opWeightMsgCall          = "op_weight_msg_gnovm"
 		defaultWeightMsgCall int = 100
@@ -0,0 +1,61 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/ignite/gnovm/x/gnovm/keeper"
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func SimulateMsgAddPackages(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Build a minimal realm importing a minimal library, given in reverse order
+		mpkgs := []*std.MemPackage{
+			{
+				Name: "r",
+				Path: "gno.land/r/demo/r",
+				Files: []*std.MemFile{
+					{
+						Name: "r.gno",
+						Body: "package r\n\nimport \"gno.land/p/demo/l\"\n\nvar V = l.V\n",
+					},
+				},
+			},
+			{
+				Name: "l",
+				Path: "gno.land/p/demo/l",
+				Files: []*std.MemFile{
+					{
+						Name: "l.gno",
+						Body: "package l\n\nconst V = 1\n",
+					},
+				},
+			},
+		}
+		msg, err := types.NewMsgAddPackages(simAccount.Address.String(), sdk.NewCoins(sdk.NewInt64Coin("ugnot", 0)), mpkgs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgAddPackages{}), err.Error()), nil, err
+		}
+
+		ms := keeper.NewMsgServerImpl(&k)
+		if _, err := ms.AddPackages(ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), err.Error()), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "add-packages executed"), nil, nil
+	}
+}
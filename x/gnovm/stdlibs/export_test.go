@@ -0,0 +1,3 @@
+package stdlibs
+
+var ChecksumFS = checksumFS
@@ -0,0 +1,113 @@
+//go:build ignore
+
+// gen copies the sources of the gno standard libraries, from the gno module
+// used by this repository, to the src directory so they can be embedded.
+//
+// Run it with `go generate ./x/gnovm/stdlibs` after updating the gno module.
+// The -o flag writes the sources to another directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gnolang/gno/gnovm/stdlibs"
+)
+
+const stdlibsPkg = "github.com/gnolang/gno/gnovm/stdlibs"
+
+var outDir = flag.String("o", "src", "output directory")
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	out, err := exec.Command("go", "list", "-f", "{{.Dir}}", stdlibsPkg).Output()
+	if err != nil {
+		return fmt.Errorf("failed to locate %s: %w", stdlibsPkg, err)
+	}
+	stdlibsDir := strings.TrimSpace(string(out))
+
+	if err := os.RemoveAll(*outDir); err != nil {
+		return err
+	}
+
+	for _, pkgPath := range stdlibs.InitOrder() {
+		if err := copyPackage(filepath.Join(stdlibsDir, pkgPath), filepath.Join(*outDir, pkgPath)); err != nil {
+			return fmt.Errorf("failed to copy stdlib %q: %w", pkgPath, err)
+		}
+	}
+
+	return nil
+}
+
+// copyPackage copies the files of a package that are read by gno.ReadMemPackage.
+func copyPackage(src, dst string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			if name == "filetests" {
+				if err := copyPackage(filepath.Join(src, name), filepath.Join(dst, name)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if !isMemPackageFile(name) {
+			continue
+		}
+		if err := copyFile(filepath.Join(src, name), filepath.Join(dst, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isMemPackageFile(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return false
+	}
+	switch filepath.Ext(name) {
+	case ".gno", ".toml", ".md":
+		return true
+	}
+	return slices.Contains([]string{"license", "license.txt", "licence", "licence.txt", "gno.mod"}, strings.ToLower(name))
+}
+
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,90 @@
+// Package stdlibs embeds the sources of the gno standard libraries, so that
+// they are loaded at genesis without requiring a Go toolchain or module cache.
+package stdlibs
+
+//go:generate go run gen.go
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+//go:embed src
+var files embed.FS
+
+// FS returns the embedded standard libraries, rooted at the stdlibs directory.
+func FS() fs.FS {
+	sub, err := fs.Sub(files, "src")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+var checksum = sync.OnceValue(func() string {
+	sum, err := checksumFS(FS())
+	if err != nil {
+		panic(err)
+	}
+	return sum
+})
+
+// Checksum returns the hex encoded sha256 checksum of the embedded standard
+// libraries. It covers the path and content of every file, in lexical order.
+func Checksum() string {
+	return checksum()
+}
+
+func checksumFS(fsys fs.FS) (string, error) {
+	h := sha256.New()
+	// fs.WalkDir walks the files in lexical order, making the checksum deterministic.
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		// the path is NUL terminated and followed by the fixed size hash of
+		// the content, so that distinct trees cannot produce the same input
+		h.Write([]byte(path))
+		h.Write([]byte{0})
+		h.Write(sha256Sum(content))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func sha256Sum(bz []byte) []byte {
+	sum := sha256.Sum256(bz)
+	return sum[:]
+}
+
+// Extract writes the embedded standard libraries to dir, as expected by the
+// VM keeper LoadStdlib method.
+func Extract(dir string) error {
+	fsys := FS()
+	return fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, filepath.FromSlash(path))
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		content, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, content, 0o644)
+	})
+}
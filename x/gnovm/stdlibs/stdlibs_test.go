@@ -0,0 +1,54 @@
+package stdlibs_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gnostdlibs "github.com/gnolang/gno/gnovm/stdlibs"
+
+	"github.com/ignite/gnovm/x/gnovm/stdlibs"
+)
+
+func TestEmbeddedStdlibs(t *testing.T) {
+	for _, pkgPath := range gnostdlibs.InitOrder() {
+		_, err := os.Stat(filepath.Join("src", pkgPath))
+		require.NoError(t, err, "stdlib %q is not embedded, run go generate", pkgPath)
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	checksum := stdlibs.Checksum()
+	require.Len(t, checksum, 64)
+	require.Equal(t, checksum, stdlibs.Checksum())
+}
+
+// TestChecksumMatchesGnoModule regenerates the standard libraries from the gno
+// module in use, and checks that the embedded ones are up to date.
+func TestChecksumMatchesGnoModule(t *testing.T) {
+	if testing.Short() {
+		t.Skip("requires the go toolchain and the gno module sources")
+	}
+
+	dir := t.TempDir()
+	out, err := exec.Command("go", "run", "gen.go", "-o", dir).CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	checksum, err := stdlibs.ChecksumFS(os.DirFS(dir))
+	require.NoError(t, err)
+	require.Equal(t, checksum, stdlibs.Checksum(), "embedded stdlibs differ from the gno module, run go generate")
+}
+
+func TestExtract(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, stdlibs.Extract(dir))
+
+	for _, pkgPath := range gnostdlibs.InitOrder() {
+		entries, err := os.ReadDir(filepath.Join(dir, pkgPath))
+		require.NoError(t, err)
+		require.NotEmpty(t, entries)
+	}
+}
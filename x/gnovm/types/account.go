@@ -40,7 +40,7 @@ func (a *accountWrapper) GetAddress() crypto.Address {
 // GetCoins implements std.Account.
 func (a *accountWrapper) GetCoins() std.Coins {
 	coins := a.bankKeeper.GetAllBalances(a.ctx, a.acc.GetAddress())
-	return StdCoinsFromSDKCoins(coins)
+	return CappedStdCoinsFromSDKCoins(coins)
 }
 
 // GetPubKey implements std.Account.
@@ -19,6 +19,20 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgAddPackage{},
 	)
 
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddPackages{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpgradePackage{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimNamespace{},
+		&MsgTransferNamespace{},
+		&MsgReleaseNamespace{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
@@ -1,25 +1,119 @@
 package types
 
 import (
+	"math"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/gnolang/gno/tm2/pkg/std"
 )
 
-// StdCoinsFromSDKCoins converts sdk.Coins to std.Coins
-func StdCoinsFromSDKCoins(coins sdk.Coins) std.Coins {
+// maxStdAmount is the largest amount of a std.Coin, which is an int64.
+var maxStdAmount = sdkmath.NewInt(math.MaxInt64)
+
+// StdCoinsFromSDKCoins converts sdk.Coins to std.Coins.
+// It fails if an amount overflows the int64 amounts of std.Coins.
+func StdCoinsFromSDKCoins(coins sdk.Coins) (std.Coins, error) {
+	stdCoins := make(std.Coins, len(coins))
+	for i, coin := range coins {
+		if !coin.Amount.IsInt64() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount %s of %s overflows int64", coin.Amount, coin.Denom)
+		}
+		stdCoins[i] = std.NewCoin(stdDenomFromSDKDenom(coin.Denom), coin.Amount.Int64())
+	}
+	return stdCoins.Sort(), nil
+}
+
+// CappedStdCoinsFromSDKCoins converts sdk.Coins to std.Coins, capping the
+// amounts which overflow int64 to math.MaxInt64.
+// It is used for the balances exposed to realms, which cannot be reported
+// with an error: a realm sees at most math.MaxInt64 of a denom.
+func CappedStdCoinsFromSDKCoins(coins sdk.Coins) std.Coins {
 	stdCoins := make(std.Coins, len(coins))
 	for i, coin := range coins {
-		stdCoins[i] = std.NewCoin(coin.Denom, coin.Amount.Int64())
+		stdCoins[i] = std.NewCoin(stdDenomFromSDKDenom(coin.Denom), sdkmath.MinInt(coin.Amount, maxStdAmount).Int64())
 	}
-	return stdCoins
+	return stdCoins.Sort()
 }
 
-// SDKCoinsFromStdCoins converts std.Coins to sdk.Coins
-func SDKCoinsFromStdCoins(amt std.Coins) sdk.Coins {
+// SDKCoinsFromStdCoins converts std.Coins to sdk.Coins.
+// It fails if a denom is not a valid bank denom, e.g. when the denom of a
+// realm exceeds the length of the bank denoms, or if an amount is negative.
+func SDKCoinsFromStdCoins(amt std.Coins) (sdk.Coins, error) {
 	coins := make(sdk.Coins, len(amt))
 	for i, coin := range amt {
-		coins[i] = sdk.NewInt64Coin(coin.Denom, coin.Amount)
+		denom := sdkDenomFromStdDenom(coin.Denom)
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+		if coin.Amount < 0 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "negative amount %d of %s", coin.Amount, denom)
+		}
+		coins[i] = sdk.NewInt64Coin(denom, coin.Amount)
+	}
+	return coins.Sort(), nil
+}
+
+// RealmDenomPrefix is the prefix of the bank denoms of the coins issued by
+// realms.
+const RealmDenomPrefix = "gno/"
+
+// RealmDenom returns the bank denom of the coins issued by a realm, i.e.
+// gno/<pkg_path>/<symbol>.
+func RealmDenom(pkgPath, symbol string) string {
+	return RealmDenomPrefix + pkgPath + "/" + symbol
+}
+
+// ParseRealmDenom returns the realm and the symbol of the bank denom of the
+// coins issued by a realm.
+func ParseRealmDenom(denom string) (pkgPath, symbol string, ok bool) {
+	rest, ok := strings.CutPrefix(denom, RealmDenomPrefix)
+	if !ok {
+		return "", "", false
+	}
+	i := strings.LastIndexByte(rest, '/')
+	if i <= 0 || i == len(rest)-1 {
+		return "", "", false
+	}
+
+	return rest[:i], rest[i+1:], true
+}
+
+// RealmDenomMetadata returns the bank metadata of a denom issued by a realm,
+// which has no decimals.
+func RealmDenomMetadata(pkgPath, symbol string) banktypes.Metadata {
+	denom := RealmDenom(pkgPath, symbol)
+	return banktypes.Metadata{
+		Description: "Coins issued by the realm " + pkgPath,
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        symbol,
+		Symbol:      strings.ToUpper(symbol),
+	}
+}
+
+// sdkDenomFromStdDenom converts the denoms of the coins issued by realms,
+// which are /<pkg_path>:<symbol> in the VM and are not valid bank denoms.
+func sdkDenomFromStdDenom(denom string) string {
+	if rest, ok := strings.CutPrefix(denom, "/"); ok {
+		if pkgPath, symbol, ok := strings.Cut(rest, ":"); ok {
+			return RealmDenom(pkgPath, symbol)
+		}
+	}
+	return denom
+}
+
+// stdDenomFromSDKDenom converts the bank denoms of the coins issued by realms
+// back to their denoms in the VM.
+func stdDenomFromSDKDenom(denom string) string {
+	if pkgPath, symbol, ok := ParseRealmDenom(denom); ok {
+		return "/" + pkgPath + ":" + symbol
 	}
-	return coins
+	return denom
 }
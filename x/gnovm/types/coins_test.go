@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestStdCoinsFromSDKCoins(t *testing.T) {
+	overflow := sdkmath.NewInt(math.MaxInt64).AddRaw(1)
+
+	tests := []struct {
+		name    string
+		coins   sdk.Coins
+		want    std.Coins
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			coins: sdk.NewCoins(),
+			want:  std.Coins{},
+		},
+		{
+			name:  "max int64",
+			coins: sdk.NewCoins(sdk.NewInt64Coin("stake", math.MaxInt64), sdk.NewInt64Coin("ugnot", 1)),
+			want:  std.Coins{std.NewCoin("stake", math.MaxInt64), std.NewCoin("ugnot", 1)},
+		},
+		{
+			name:    "overflow",
+			coins:   sdk.NewCoins(sdk.NewCoin("stake", overflow), sdk.NewInt64Coin("ugnot", 1)),
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := types.StdCoinsFromSDKCoins(tc.coins)
+			if tc.wantErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCappedStdCoinsFromSDKCoins(t *testing.T) {
+	coins := sdk.NewCoins(
+		sdk.NewCoin("stake", sdkmath.NewInt(math.MaxInt64).MulRaw(1000)),
+		sdk.NewInt64Coin("ugnot", 42),
+	)
+
+	require.Equal(t, std.Coins{
+		std.NewCoin("stake", math.MaxInt64),
+		std.NewCoin("ugnot", 42),
+	}, types.CappedStdCoinsFromSDKCoins(coins))
+}
+
+func TestRealmDenoms(t *testing.T) {
+	stdCoins := std.Coins{
+		std.NewCoin("/gno.land/r/demo/token:tok", 10),
+		std.NewCoin("stake", 20),
+	}
+	sdkCoins := sdk.NewCoins(
+		sdk.NewInt64Coin("gno/gno.land/r/demo/token/tok", 10),
+		sdk.NewInt64Coin("stake", 20),
+	)
+
+	converted, err := types.SDKCoinsFromStdCoins(stdCoins)
+	require.NoError(t, err)
+	require.Equal(t, sdkCoins, converted)
+	got, err := types.StdCoinsFromSDKCoins(sdkCoins)
+	require.NoError(t, err)
+	require.Equal(t, stdCoins, got)
+
+	pkgPath, symbol, ok := types.ParseRealmDenom("gno/gno.land/r/demo/token/tok")
+	require.True(t, ok)
+	require.Equal(t, "gno.land/r/demo/token", pkgPath)
+	require.Equal(t, "tok", symbol)
+
+	for _, denom := range []string{"stake", "gno/tok", "gno/gno.land/r/demo/token/", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"} {
+		_, _, ok := types.ParseRealmDenom(denom)
+		require.False(t, ok, denom)
+	}
+}
+
+func TestRealmDenomMetadata(t *testing.T) {
+	metadata := types.RealmDenomMetadata("gno.land/r/demo/token", "tok")
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, "gno/gno.land/r/demo/token/tok", metadata.Base)
+	require.Equal(t, "TOK", metadata.Symbol)
+}
+
+func TestSDKCoinsFromStdCoins_Invalid(t *testing.T) {
+	// the bank denoms are at most 128 characters long
+	longPath := "gno.land/r/demo/" + strings.Repeat("token", 24)
+	_, err := types.SDKCoinsFromStdCoins(std.Coins{std.NewCoin("/"+longPath+":tok", 10)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	_, err = types.SDKCoinsFromStdCoins(std.Coins{{Denom: "stake", Amount: -1}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+}
@@ -6,5 +6,25 @@ import "cosmossdk.io/errors"
 
 // x/gnovm module errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner         = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrNamespaceClaimed      = errors.Register(ModuleName, 1101, "namespace already claimed")
+	ErrUnauthorizedNamespace = errors.Register(ModuleName, 1102, "unauthorized namespace")
+	ErrUnauthorizedDeployer  = errors.Register(ModuleName, 1103, "unauthorized deployer")
+	ErrUnauthorizedUpgrade   = errors.Register(ModuleName, 1104, "unauthorized upgrade")
+)
+
+// VM failures, so that clients can tell them apart by their ABCI code.
+// Running out of gas keeps the ErrOutOfGas code of the SDK, as for any other
+// transaction.
+var (
+	ErrInvalidPackage      = errors.Register(ModuleName, 1200, "invalid package")
+	ErrTypeCheck           = errors.Register(ModuleName, 1201, "type check failed")
+	ErrRealmPanic          = errors.Register(ModuleName, 1202, "realm panicked")
+	ErrInsufficientDeposit = errors.Register(ModuleName, 1203, "insufficient storage deposit")
+	ErrPackageNotFound     = errors.Register(ModuleName, 1204, "package not found")
+	ErrPackageExists       = errors.Register(ModuleName, 1205, "package already exists")
+	ErrRestrictedDenom     = errors.Register(ModuleName, 1206, "restricted denom")
+	ErrUnauthorizedMint    = errors.Register(ModuleName, 1207, "unauthorized mint")
+	ErrSupplyCapExceeded   = errors.Register(ModuleName, 1208, "supply cap exceeded")
+	ErrIncompatibleUpgrade = errors.Register(ModuleName, 1209, "incompatible package upgrade")
 )
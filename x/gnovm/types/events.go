@@ -0,0 +1,110 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	gnosdk "github.com/gnolang/gno/tm2/pkg/sdk"
+)
+
+// GnoVM events attributes
+const (
+	AttributeKeyPkgPath        = "pkg_path"
+	AttributeKeyFunc           = "func"
+	AttributeKeyBytesDelta     = "bytes_delta"
+	AttributeKeyFeeDelta       = "fee_delta"
+	AttributeKeyFeeRefund      = "fee_refund"
+	AttributeKeyRefundWithheld = "refund_withheld"
+	AttributeKeyData           = "data"
+	AttributeKeyNamespace      = "namespace"
+	AttributeKeyOwner          = "owner"
+	AttributeKeyPayer          = "payer"
+	AttributeKeyLocked         = "locked"
+	AttributeKeyUnlocked       = "unlocked"
+	AttributeKeyRefunded       = "refunded"
+	AttributeKeyStacktrace     = "stacktrace"
+	AttributeKeySender         = "sender"
+)
+
+// GnoVM events types, realm events (emitted with chain.Emit) keep the event
+// type given by the realm.
+const (
+	EventTypeStorageDeposit = "StorageDeposit"
+	EventTypeStorageUnlock  = "StorageUnlock"
+)
+
+// EventTypeNamespaceOwner is emitted when the owner of a namespace changes,
+// the owner is empty when the namespace is released.
+const EventTypeNamespaceOwner = "NamespaceOwner"
+
+// EventTypePayerStorageDeposit is emitted by the messages changing the storage
+// of realms, with the storage deposit locked and unlocked for the payer across
+// realms. The unlocked deposit is refunded to the payer, unless withheld.
+const EventTypePayerStorageDeposit = "payer_storage_deposit"
+
+// EventTypeRealmPanic is emitted when a realm panics, with the stacktrace of
+// the panic.
+const EventTypeRealmPanic = "realm_panic"
+
+// EventTypeUpgradePackage is emitted when the code of a package is upgraded,
+// with the sender of the upgrade.
+const EventTypeUpgradePackage = "upgrade_package"
+
+// SDKEventsFromGnoEvents converts the events collected by the VM to sdk.Events.
+// The fn is the function executed by the message, and is added to every event
+// along with the package path of the realm that emitted the event.
+func SDKEventsFromGnoEvents(events []gnosdk.Event, fn string) sdk.Events {
+	sdkEvents := make(sdk.Events, len(events))
+	for i, event := range events {
+		sdkEvents[i] = SDKEventFromGnoEvent(event, fn)
+	}
+	return sdkEvents
+}
+
+// SDKEventFromGnoEvent converts an event collected by the VM to an sdk.Event.
+func SDKEventFromGnoEvent(event gnosdk.Event, fn string) sdk.Event {
+	switch evt := event.(type) {
+	case chain.Event:
+		attrs := make([]sdk.Attribute, 0, len(evt.Attributes)+2)
+		for _, attr := range evt.Attributes {
+			attrs = append(attrs, sdk.NewAttribute(attr.Key, attr.Value))
+		}
+		attrs = append(attrs,
+			sdk.NewAttribute(AttributeKeyPkgPath, evt.PkgPath),
+			sdk.NewAttribute(AttributeKeyFunc, fn),
+		)
+		return sdk.NewEvent(evt.Type, attrs...)
+
+	case chain.StorageDepositEvent:
+		return sdk.NewEvent(EventTypeStorageDeposit,
+			sdk.NewAttribute(AttributeKeyBytesDelta, strconv.FormatInt(evt.BytesDelta, 10)),
+			sdk.NewAttribute(AttributeKeyFeeDelta, evt.FeeDelta.String()),
+			sdk.NewAttribute(AttributeKeyPkgPath, evt.PkgPath),
+			sdk.NewAttribute(AttributeKeyFunc, fn),
+		)
+
+	case chain.StorageUnlockEvent:
+		return sdk.NewEvent(EventTypeStorageUnlock,
+			sdk.NewAttribute(AttributeKeyBytesDelta, strconv.FormatInt(evt.BytesDelta, 10)),
+			sdk.NewAttribute(AttributeKeyFeeRefund, evt.FeeRefund.String()),
+			sdk.NewAttribute(AttributeKeyRefundWithheld, strconv.FormatBool(evt.RefundWithheld)),
+			sdk.NewAttribute(AttributeKeyPkgPath, evt.PkgPath),
+			sdk.NewAttribute(AttributeKeyFunc, fn),
+		)
+
+	default:
+		// unknown events are kept as JSON so they are not lost
+		data, err := json.Marshal(evt)
+		if err != nil {
+			data = []byte(err.Error())
+		}
+		return sdk.NewEvent(reflect.TypeOf(evt).Name(),
+			sdk.NewAttribute(AttributeKeyData, string(data)),
+			sdk.NewAttribute(AttributeKeyFunc, fn),
+		)
+	}
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	gnosdk "github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestSDKEventsFromGnoEvents(t *testing.T) {
+	events := []gnosdk.Event{
+		chain.Event{
+			Type: "Transfer",
+			Attributes: []chain.EventAttribute{
+				{Key: "from", Value: "alice"},
+				{Key: "to", Value: "bob"},
+			},
+			PkgPath: "gno.land/r/demo/token",
+		},
+		chain.StorageDepositEvent{
+			BytesDelta: 42,
+			FeeDelta:   std.NewCoin("stake", 42),
+			PkgPath:    "gno.land/r/demo/token",
+		},
+		chain.StorageUnlockEvent{
+			BytesDelta:     -10,
+			FeeRefund:      std.NewCoin("stake", 10),
+			PkgPath:        "gno.land/r/demo/token",
+			RefundWithheld: true,
+		},
+	}
+
+	got := types.SDKEventsFromGnoEvents(events, "Transfer")
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent("Transfer",
+			sdk.NewAttribute("from", "alice"),
+			sdk.NewAttribute("to", "bob"),
+			sdk.NewAttribute(types.AttributeKeyPkgPath, "gno.land/r/demo/token"),
+			sdk.NewAttribute(types.AttributeKeyFunc, "Transfer"),
+		),
+		sdk.NewEvent(types.EventTypeStorageDeposit,
+			sdk.NewAttribute(types.AttributeKeyBytesDelta, "42"),
+			sdk.NewAttribute(types.AttributeKeyFeeDelta, "42stake"),
+			sdk.NewAttribute(types.AttributeKeyPkgPath, "gno.land/r/demo/token"),
+			sdk.NewAttribute(types.AttributeKeyFunc, "Transfer"),
+		),
+		sdk.NewEvent(types.EventTypeStorageUnlock,
+			sdk.NewAttribute(types.AttributeKeyBytesDelta, "-10"),
+			sdk.NewAttribute(types.AttributeKeyFeeRefund, "10stake"),
+			sdk.NewAttribute(types.AttributeKeyRefundWithheld, "true"),
+			sdk.NewAttribute(types.AttributeKeyPkgPath, "gno.land/r/demo/token"),
+			sdk.NewAttribute(types.AttributeKeyFunc, "Transfer"),
+		),
+	}, got)
+}
+
+func TestSDKEventsFromGnoEvents_Empty(t *testing.T) {
+	require.Empty(t, types.SDKEventsFromGnoEvents(nil, "main"))
+}
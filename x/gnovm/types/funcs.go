@@ -0,0 +1,202 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+)
+
+// RealmType is the type of the realm parameter of crossing functions.
+const RealmType = "realm"
+
+// gnoRealmType is the type of the realm parameter as returned by the VM, which
+// describes the underlying interface of the realm type.
+var gnoRealmType = sync.OnceValue(func() string {
+	return gno.BaseOf(gno.UverseNode().GetSlot(nil, RealmType, true).GetType()).String()
+})
+
+// FunctionSignaturesFromGno converts the function signatures returned by the VM.
+func FunctionSignaturesFromGno(fsigs vm.FunctionSignatures) []FunctionSignature {
+	res := make([]FunctionSignature, 0, len(fsigs))
+	for _, fsig := range fsigs {
+		res = append(res, FunctionSignature{
+			Name:    fsig.FuncName,
+			Params:  namedTypesFromGno(fsig.Params),
+			Results: namedTypesFromGno(fsig.Results),
+		})
+	}
+
+	return res
+}
+
+func namedTypesFromGno(nts []vm.NamedType) []NamedType {
+	res := make([]NamedType, 0, len(nts))
+	for _, nt := range nts {
+		name, typ := nt.Name, nt.Type
+		// unnamed parameters and results are given hidden names by the VM
+		if strings.HasPrefix(name, ".") {
+			name = "_"
+		}
+		if typ == gnoRealmType() {
+			typ = RealmType
+		}
+		res = append(res, NamedType{Name: name, Type: typ})
+	}
+
+	return res
+}
+
+// IsCrossing returns whether the function takes the realm of its caller as
+// first parameter. Only crossing functions can be called with MsgCall.
+func (fs FunctionSignature) IsCrossing() bool {
+	return len(fs.Params) > 0 && fs.Params[0].Type == RealmType
+}
+
+// ValidateCallArgs checks that the args of a MsgCall can be converted by the
+// VM to the parameters of the function.
+func (fs FunctionSignature) ValidateCallArgs(args []string) error {
+	if !fs.IsCrossing() {
+		return fmt.Errorf("function %s is non-crossing and cannot be called with MsgCall", fs.Name)
+	}
+
+	// the realm parameter is provided by the VM
+	params := fs.Params[1:]
+	if len(args) != len(params) {
+		return fmt.Errorf("wrong number of arguments in call to %s: want %d got %d", fs.Name, len(params), len(args))
+	}
+
+	for i, arg := range args {
+		if err := validateCallArg(params[i].Type, arg); err != nil {
+			return fmt.Errorf("invalid argument #%d (%s) in call to %s: %w", i+1, params[i].Name, fs.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateJSONArgs checks that the json_args of a MsgCall hold as many
+// arguments as the parameters of the function. The arguments themselves are
+// decoded against the types of the parameters by the keeper, as the
+// signatures only describe the declared types by name.
+func (fs FunctionSignature) ValidateJSONArgs(jsonArgs string) error {
+	if !fs.IsCrossing() {
+		return fmt.Errorf("function %s is non-crossing and cannot be called with MsgCall", fs.Name)
+	}
+
+	var values []json.RawMessage
+	if err := json.Unmarshal([]byte(jsonArgs), &values); err != nil {
+		return fmt.Errorf("invalid JSON arguments in call to %s, expected a JSON array: %w", fs.Name, err)
+	}
+	if params := fs.Params[1:]; len(values) != len(params) {
+		return fmt.Errorf("wrong number of arguments in call to %s: want %d got %d", fs.Name, len(params), len(values))
+	}
+
+	return nil
+}
+
+// declaredTypeRe matches the declared types described by the VM, e.g.
+// gno.land/r/demo/orders.Status.
+var declaredTypeRe = regexp.MustCompile(`^[\w./~-]+\.\w+$`)
+
+// validateCallArg mirrors the conversion of the call arguments made by the VM.
+func validateCallArg(typ, arg string) error {
+	switch {
+	case typ == ".uverse.address":
+		typ = "address"
+	case declaredTypeRe.MatchString(typ):
+		// the VM converts the args by the underlying type of declared
+		// types, which the signatures do not describe
+		return nil
+	}
+
+	switch typ {
+	case "address":
+		if _, err := sdk.AccAddressFromBech32(arg); err != nil {
+			return fmt.Errorf("expected a bech32 address, got %q", arg)
+		}
+		return nil
+	case "bool":
+		if arg != "true" && arg != "false" {
+			return fmt.Errorf("expected true or false, got %q", arg)
+		}
+		return nil
+	case "string":
+		return nil
+	case "float32":
+		if _, err := strconv.ParseFloat(arg, 32); err != nil {
+			return fmt.Errorf("expected %s, got %q", typ, arg)
+		}
+		return nil
+	case "float64":
+		if _, err := strconv.ParseFloat(arg, 64); err != nil {
+			return fmt.Errorf("expected %s, got %q", typ, arg)
+		}
+		return nil
+	}
+
+	// byte slices and arrays are base64 encoded
+	if strings.HasPrefix(typ, "[") && strings.HasSuffix(typ, "]uint8") {
+		if _, err := base64.StdEncoding.DecodeString(arg); err != nil {
+			return fmt.Errorf("expected base64 encoded bytes, got %q", arg)
+		}
+		return nil
+	}
+
+	if _, _, ok := integerKind(typ); !ok {
+		return fmt.Errorf("unsupported argument type %s", typ)
+	}
+
+	if strings.HasPrefix(arg, "+") {
+		return fmt.Errorf("expected %s without a plus sign, got %q", typ, arg)
+	}
+	if _, err := parseInteger(typ, arg); err != nil {
+		return fmt.Errorf("expected %s, got %q", typ, arg)
+	}
+
+	return nil
+}
+
+// integerKind returns the bit size and signedness of the integer types.
+func integerKind(typ string) (bitSize int, unsigned bool, ok bool) {
+	switch typ {
+	case "int", "int64":
+		return 64, false, true
+	case "uint", "uint64":
+		return 64, true, true
+	case "int8":
+		return 8, false, true
+	case "uint8":
+		return 8, true, true
+	case "int16":
+		return 16, false, true
+	case "uint16":
+		return 16, true, true
+	case "int32":
+		return 32, false, true
+	case "uint32":
+		return 32, true, true
+	default:
+		return 0, false, false
+	}
+}
+
+// parseInteger parses a decimal integer of the given integer type, and returns
+// it in its canonical form.
+func parseInteger(typ, s string) (string, error) {
+	bitSize, unsigned, _ := integerKind(typ)
+	if unsigned {
+		n, err := strconv.ParseUint(s, 10, bitSize)
+		return strconv.FormatUint(n, 10), err
+	}
+	n, err := strconv.ParseInt(s, 10, bitSize)
+	return strconv.FormatInt(n, 10), err
+}
@@ -0,0 +1,173 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestFunctionSignature_ValidateCallArgs(t *testing.T) {
+	fsig := types.FunctionSignature{
+		Name: "Transfer",
+		Params: []types.NamedType{
+			{Name: "cur", Type: types.RealmType},
+			{Name: "to", Type: "string"},
+			{Name: "amount", Type: "int64"},
+			{Name: "fee", Type: "uint8"},
+			{Name: "rate", Type: "float64"},
+			{Name: "memo", Type: "[]uint8"},
+			{Name: "dry", Type: "bool"},
+		},
+	}
+
+	tests := []struct {
+		desc string
+		fsig types.FunctionSignature
+		args []string
+		err  string
+	}{
+		{
+			desc: "valid",
+			fsig: fsig,
+			args: []string{"alice", "-42", "255", "0.5", "aGVsbG8=", "true"},
+		},
+		{
+			desc: "wrong number of arguments",
+			fsig: fsig,
+			args: []string{"alice"},
+			err:  "wrong number of arguments in call to Transfer: want 6 got 1",
+		},
+		{
+			desc: "invalid int",
+			fsig: fsig,
+			args: []string{"alice", "ten", "255", "0.5", "aGVsbG8=", "true"},
+			err:  "invalid argument #2 (amount)",
+		},
+		{
+			desc: "int with plus sign",
+			fsig: fsig,
+			args: []string{"alice", "+42", "255", "0.5", "aGVsbG8=", "true"},
+			err:  "without a plus sign",
+		},
+		{
+			desc: "uint overflow",
+			fsig: fsig,
+			args: []string{"alice", "42", "256", "0.5", "aGVsbG8=", "true"},
+			err:  "invalid argument #3 (fee)",
+		},
+		{
+			desc: "invalid float",
+			fsig: fsig,
+			args: []string{"alice", "42", "255", "half", "aGVsbG8=", "true"},
+			err:  "invalid argument #4 (rate)",
+		},
+		{
+			desc: "invalid bytes",
+			fsig: fsig,
+			args: []string{"alice", "42", "255", "0.5", "hello!", "true"},
+			err:  "invalid argument #5 (memo)",
+		},
+		{
+			desc: "invalid bool",
+			fsig: fsig,
+			args: []string{"alice", "42", "255", "0.5", "aGVsbG8=", "yes"},
+			err:  "invalid argument #6 (dry)",
+		},
+		{
+			desc: "non-crossing function",
+			fsig: types.FunctionSignature{Name: "Render", Params: []types.NamedType{{Name: "path", Type: "string"}}},
+			args: []string{""},
+			err:  "function Render is non-crossing",
+		},
+		{
+			desc: "address",
+			fsig: types.FunctionSignature{Name: "Send", Params: []types.NamedType{{Name: "_", Type: types.RealmType}, {Name: "to", Type: ".uverse.address"}}},
+			args: []string{sdk.AccAddress("to__________________").String()},
+		},
+		{
+			desc: "invalid address",
+			fsig: types.FunctionSignature{Name: "Send", Params: []types.NamedType{{Name: "_", Type: types.RealmType}, {Name: "to", Type: ".uverse.address"}}},
+			args: []string{"alice"},
+			err:  "invalid argument #1 (to) in call to Send: expected a bech32 address",
+		},
+		{
+			desc: "declared type",
+			fsig: types.FunctionSignature{Name: "SetStatus", Params: []types.NamedType{{Name: "_", Type: types.RealmType}, {Name: "s", Type: "gno.land/r/demo/orders.Status"}}},
+			args: []string{"1"},
+		},
+		{
+			desc: "unsupported type",
+			fsig: types.FunctionSignature{Name: "Set", Params: []types.NamedType{{Name: "_", Type: types.RealmType}, {Name: "v", Type: "[]string"}}},
+			args: []string{"a"},
+			err:  "unsupported argument type []string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.fsig.ValidateCallArgs(tc.args)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFunctionSignature_ValidateJSONArgs(t *testing.T) {
+	fsig := types.FunctionSignature{
+		Name: "Buy",
+		Params: []types.NamedType{
+			{Name: "_", Type: types.RealmType},
+			{Name: "item", Type: "gno.land/r/demo/shop.Item"},
+			{Name: "tags", Type: "[]string"},
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		fsig     types.FunctionSignature
+		jsonArgs string
+		err      string
+	}{
+		{
+			desc:     "valid",
+			fsig:     fsig,
+			jsonArgs: `[{"Name": "a"}, ["b"]]`,
+		},
+		{
+			desc:     "wrong number of arguments",
+			fsig:     fsig,
+			jsonArgs: `[{"Name": "a"}]`,
+			err:      "wrong number of arguments in call to Buy: want 2 got 1",
+		},
+		{
+			desc:     "not an array",
+			fsig:     fsig,
+			jsonArgs: `{"item": {}}`,
+			err:      "expected a JSON array",
+		},
+		{
+			desc:     "non-crossing",
+			fsig:     types.FunctionSignature{Name: "Render", Params: []types.NamedType{{Name: "path", Type: "string"}}},
+			jsonArgs: `[""]`,
+			err:      "function Render is non-crossing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.fsig.ValidateJSONArgs(tc.jsonArgs)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
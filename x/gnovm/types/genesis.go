@@ -1,5 +1,44 @@
 package types
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// PackagesVersion is the version of the structured packages export format.
+const PackagesVersion = 1
+
+// FlagExportFormat is the app option selecting the genesis export format.
+const FlagExportFormat = "gnovm.export-format"
+
+// ExportFormat is the format of the VM state in the exported genesis.
+type ExportFormat string
+
+const (
+	// ExportFormatKV exports the VM store as raw key-value pairs (state).
+	ExportFormatKV ExportFormat = "kv"
+	// ExportFormatPackages exports the packages sources and their objects (packages).
+	ExportFormatPackages ExportFormat = "packages"
+)
+
+// ParseExportFormat parses an export format, an empty format defaults to ExportFormatKV.
+func ParseExportFormat(format string) (ExportFormat, error) {
+	switch ExportFormat(format) {
+	case "", ExportFormatKV:
+		return ExportFormatKV, nil
+	case ExportFormatPackages:
+		return ExportFormatPackages, nil
+	default:
+		return "", fmt.Errorf("unknown export format %q, must be %q or %q", format, ExportFormatKV, ExportFormatPackages)
+	}
+}
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +49,132 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if gs.StdlibsChecksum != "" {
+		bz, err := hex.DecodeString(gs.StdlibsChecksum)
+		if err != nil || len(bz) != sha256.Size {
+			return fmt.Errorf("invalid stdlibs checksum %q: must be a hex encoded sha256 checksum", gs.StdlibsChecksum)
+		}
+	}
+
+	if len(gs.Packages) > 0 {
+		if gs.PackagesVersion != PackagesVersion {
+			return fmt.Errorf("unsupported packages version %d, expected %d", gs.PackagesVersion, PackagesVersion)
+		}
+		if len(gs.State) > 0 {
+			return fmt.Errorf("state and packages cannot be both set")
+		}
+	}
+	for i, pkg := range gs.Packages {
+		if _, err := pkg.MemPackage(); err != nil {
+			return fmt.Errorf("invalid package #%d: %w", i, err)
+		}
+		if pkg.Creator != "" {
+			if _, err := sdk.AccAddressFromBech32(pkg.Creator); err != nil {
+				return fmt.Errorf("invalid package #%d: invalid creator address: %w", i, err)
+			}
+		}
+		if pkg.Admin != "" {
+			if _, err := sdk.AccAddressFromBech32(pkg.Admin); err != nil {
+				return fmt.Errorf("invalid package #%d: invalid admin address: %w", i, err)
+			}
+		}
+	}
+
+	pkgPaths := make(map[string]struct{}, len(gs.GenesisPackages))
+	for i, pkg := range gs.GenesisPackages {
+		mpkg, err := pkg.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid genesis package #%d: %w", i, err)
+		}
+		if _, ok := pkgPaths[mpkg.Path]; ok {
+			return fmt.Errorf("duplicate genesis package %s", mpkg.Path)
+		}
+		pkgPaths[mpkg.Path] = struct{}{}
+	}
+
+	namespaces := make(map[string]struct{}, len(gs.Namespaces))
+	for _, ns := range gs.Namespaces {
+		if err := ns.Validate(); err != nil {
+			return err
+		}
+		if _, ok := namespaces[ns.Name]; ok {
+			return fmt.Errorf("duplicate namespace %s", ns.Name)
+		}
+		namespaces[ns.Name] = struct{}{}
+	}
+
+	deposits := make(map[[2]string]struct{}, len(gs.StorageDeposits))
+	for _, deposit := range gs.StorageDeposits {
+		if err := deposit.Validate(); err != nil {
+			return err
+		}
+		key := [2]string{deposit.PkgPath, deposit.Payer}
+		if _, ok := deposits[key]; ok {
+			return fmt.Errorf("duplicate storage deposit of %s for %s", deposit.Payer, deposit.PkgPath)
+		}
+		deposits[key] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
+
+// NewGenesisPackage creates a new GenesisPackage, the init call is optional.
+func NewGenesisPackage(creator string, mpkg *std.MemPackage, initCall *GenesisPackageCall) (GenesisPackage, error) {
+	pkgBz, err := json.Marshal(mpkg)
+	if err != nil {
+		return GenesisPackage{}, fmt.Errorf("failed to marshal package: %w", err)
+	}
+
+	return GenesisPackage{
+		Creator:  creator,
+		Package:  pkgBz,
+		InitCall: initCall,
+	}, nil
+}
+
+// Validate performs basic validation of the genesis package and returns the
+// decoded gno package.
+func (gp GenesisPackage) Validate() (*std.MemPackage, error) {
+	if _, err := sdk.AccAddressFromBech32(gp.Creator); err != nil {
+		return nil, fmt.Errorf("invalid creator address: %w", err)
+	}
+	if gp.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(gp.Admin); err != nil {
+			return nil, fmt.Errorf("invalid admin address: %w", err)
+		}
+	}
+
+	mpkg, err := gp.MemPackage()
+	if err != nil {
+		return nil, err
+	}
+
+	if gp.InitCall != nil && gp.InitCall.Function == "" {
+		return nil, fmt.Errorf("empty init call function for package %s", mpkg.Path)
+	}
+
+	return mpkg, nil
+}
+
+// MemPackage decodes the gno package of the genesis package.
+func (gp GenesisPackage) MemPackage() (*std.MemPackage, error) {
+	return decodeMemPackage(gp.Package)
+}
+
+// MemPackage decodes the gno package source of the package state.
+func (ps PackageState) MemPackage() (*std.MemPackage, error) {
+	return decodeMemPackage(ps.Package)
+}
+
+// decodeMemPackage decodes and validates a JSON encoded gno package.
+func decodeMemPackage(bz []byte) (*std.MemPackage, error) {
+	var mpkg std.MemPackage
+	if err := json.Unmarshal(bz, &mpkg); err != nil {
+		return nil, fmt.Errorf("invalid package: %w", err)
+	}
+	if err := mpkg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid package: %w", err)
+	}
+
+	return &mpkg, nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -33,6 +34,25 @@ type GenesisState struct {
 	// state contains all key-value pairs from the gnovm store.
 	// currently that part of the state should NOT be modified manually.
 	State []KVPair `protobuf:"bytes,3,rep,name=state,proto3" json:"state"`
+	// stdlibs_checksum is the hex encoded sha256 checksum of the gno standard
+	// libraries loaded at genesis. When set, nodes whose embedded standard
+	// libraries have a different checksum refuse to initialize the chain.
+	StdlibsChecksum string `protobuf:"bytes,4,opt,name=stdlibs_checksum,json=stdlibsChecksum,proto3" json:"stdlibs_checksum,omitempty"`
+	// genesis_packages are the gno packages deployed at genesis, in order,
+	// after the standard libraries are loaded.
+	GenesisPackages []GenesisPackage `protobuf:"bytes,5,rep,name=genesis_packages,json=genesisPackages,proto3" json:"genesis_packages"`
+	// packages is the structured export of the deployed gno packages, in
+	// deployment order. It is an alternative to state, from which the VM state
+	// is rebuilt at genesis, see PackageState.
+	Packages []PackageState `protobuf:"bytes,6,rep,name=packages,proto3" json:"packages"`
+	// packages_version is the version of the packages format.
+	PackagesVersion uint32 `protobuf:"varint,7,opt,name=packages_version,json=packagesVersion,proto3" json:"packages_version,omitempty"`
+	// namespaces are the claimed namespaces. They are part of state when the
+	// VM state is exported as key-value pairs.
+	Namespaces []Namespace `protobuf:"bytes,8,rep,name=namespaces,proto3" json:"namespaces"`
+	// storage_deposits are the storage deposits locked by each payer. They are
+	// part of state when the VM state is exported as key-value pairs.
+	StorageDeposits []StorageDeposit `protobuf:"bytes,9,rep,name=storage_deposits,json=storageDeposits,proto3" json:"storage_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,6 +109,268 @@ func (m *GenesisState) GetState() []KVPair {
 	return nil
 }
 
+func (m *GenesisState) GetStdlibsChecksum() string {
+	if m != nil {
+		return m.StdlibsChecksum
+	}
+	return ""
+}
+
+func (m *GenesisState) GetGenesisPackages() []GenesisPackage {
+	if m != nil {
+		return m.GenesisPackages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPackages() []PackageState {
+	if m != nil {
+		return m.Packages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPackagesVersion() uint32 {
+	if m != nil {
+		return m.PackagesVersion
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNamespaces() []Namespace {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageDeposits() []StorageDeposit {
+	if m != nil {
+		return m.StorageDeposits
+	}
+	return nil
+}
+
+// PackageState is the structured export of a deployed gno package.
+//
+// Version 1 of the format is made of the package source and of the objects
+// persisted by the VM for the package. Types and nodes are not exported, they
+// are rebuilt by the VM from the package source.
+//
+// The realm and the objects are a raw dump of their encoding by the VM, they
+// are not converted to a VM independent form. Hence the format does not
+// survive a change of the VM encoding: importing the packages with a VM that
+// encodes them differently is not supported.
+type PackageState struct {
+	// package is the JSON encoded package source (std.MemPackage).
+	Package []byte `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// realm is the amino JSON encoded realm record (gnolang.Realm), holding
+	// the object counter and the storage deposit of the realm. It is empty for
+	// pure packages.
+	Realm []byte `protobuf:"bytes,2,opt,name=realm,proto3" json:"realm,omitempty"`
+	// objects are the amino JSON encoded objects of the package (gnolang.Object),
+	// starting with the package value.
+	Objects [][]byte `protobuf:"bytes,3,rep,name=objects,proto3" json:"objects,omitempty"`
+	// creator is the address that deployed the package, if known.
+	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// admin is the address that can upgrade the package, if any.
+	Admin string `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *PackageState) Reset()         { *m = PackageState{} }
+func (m *PackageState) String() string { return proto.CompactTextString(m) }
+func (*PackageState) ProtoMessage()    {}
+func (*PackageState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac7a218a72ed0c95, []int{1}
+}
+func (m *PackageState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PackageState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PackageState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PackageState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PackageState.Merge(m, src)
+}
+func (m *PackageState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PackageState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PackageState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PackageState proto.InternalMessageInfo
+
+func (m *PackageState) GetPackage() []byte {
+	if m != nil {
+		return m.Package
+	}
+	return nil
+}
+
+func (m *PackageState) GetRealm() []byte {
+	if m != nil {
+		return m.Realm
+	}
+	return nil
+}
+
+func (m *PackageState) GetObjects() [][]byte {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *PackageState) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PackageState) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// GenesisPackage represents a gno package deployed at genesis.
+type GenesisPackage struct {
+	// creator is the address deploying the package, it pays the storage deposit.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// package is the JSON encoded gno package, as in MsgAddPackage.
+	Package []byte `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	// init_call is an optional call made by the creator once the package is deployed.
+	InitCall *GenesisPackageCall `protobuf:"bytes,3,opt,name=init_call,json=initCall,proto3" json:"init_call,omitempty"`
+	// admin is an optional address allowed to upgrade the package.
+	Admin string `protobuf:"bytes,4,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *GenesisPackage) Reset()         { *m = GenesisPackage{} }
+func (m *GenesisPackage) String() string { return proto.CompactTextString(m) }
+func (*GenesisPackage) ProtoMessage()    {}
+func (*GenesisPackage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac7a218a72ed0c95, []int{2}
+}
+func (m *GenesisPackage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPackage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPackage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisPackage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPackage.Merge(m, src)
+}
+func (m *GenesisPackage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPackage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPackage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPackage proto.InternalMessageInfo
+
+func (m *GenesisPackage) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *GenesisPackage) GetPackage() []byte {
+	if m != nil {
+		return m.Package
+	}
+	return nil
+}
+
+func (m *GenesisPackage) GetInitCall() *GenesisPackageCall {
+	if m != nil {
+		return m.InitCall
+	}
+	return nil
+}
+
+func (m *GenesisPackage) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// GenesisPackageCall represents a function call of a genesis package.
+type GenesisPackageCall struct {
+	Function string   `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	Args     []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (m *GenesisPackageCall) Reset()         { *m = GenesisPackageCall{} }
+func (m *GenesisPackageCall) String() string { return proto.CompactTextString(m) }
+func (*GenesisPackageCall) ProtoMessage()    {}
+func (*GenesisPackageCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac7a218a72ed0c95, []int{3}
+}
+func (m *GenesisPackageCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPackageCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPackageCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisPackageCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPackageCall.Merge(m, src)
+}
+func (m *GenesisPackageCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPackageCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPackageCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPackageCall proto.InternalMessageInfo
+
+func (m *GenesisPackageCall) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *GenesisPackageCall) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
 // KVPair represents a key-value pair from the store.
 type KVPair struct {
 	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -99,7 +381,7 @@ func (m *KVPair) Reset()         { *m = KVPair{} }
 func (m *KVPair) String() string { return proto.CompactTextString(m) }
 func (*KVPair) ProtoMessage()    {}
 func (*KVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac7a218a72ed0c95, []int{1}
+	return fileDescriptor_ac7a218a72ed0c95, []int{4}
 }
 func (m *KVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,31 +426,55 @@ func (m *KVPair) GetValue() []byte {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gnovm.gnovm.v1.GenesisState")
+	proto.RegisterType((*PackageState)(nil), "gnovm.gnovm.v1.PackageState")
+	proto.RegisterType((*GenesisPackage)(nil), "gnovm.gnovm.v1.GenesisPackage")
+	proto.RegisterType((*GenesisPackageCall)(nil), "gnovm.gnovm.v1.GenesisPackageCall")
 	proto.RegisterType((*KVPair)(nil), "gnovm.gnovm.v1.KVPair")
 }
 
 func init() { proto.RegisterFile("gnovm/gnovm/v1/genesis.proto", fileDescriptor_ac7a218a72ed0c95) }
 
 var fileDescriptor_ac7a218a72ed0c95 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xcf, 0xcb, 0x2f,
-	0xcb, 0xd5, 0x87, 0x90, 0x65, 0x86, 0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05,
-	0x45, 0xf9, 0x25, 0xf9, 0x42, 0x7c, 0x60, 0x71, 0x3d, 0x08, 0x59, 0x66, 0x28, 0x25, 0x98, 0x98,
-	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x4a, 0xa4, 0xa4, 0xd1, 0x0c, 0x28, 0x48, 0x2c, 0x4a,
-	0xcc, 0x85, 0xea, 0x97, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8,
-	0xd2, 0x02, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0x3d, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x96, 0x5c,
-	0x6c, 0x10, 0x6d, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x62, 0x7a, 0xa8, 0xf6, 0xea, 0x05,
-	0x80, 0x65, 0x9d, 0x38, 0x4f, 0xdc, 0x93, 0x67, 0x58, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10, 0x54,
-	0x83, 0x90, 0x22, 0x17, 0x4f, 0x51, 0x6a, 0x62, 0x4e, 0x6e, 0x3c, 0xd4, 0x00, 0x26, 0x05, 0x46,
-	0x0d, 0x9e, 0x20, 0x6e, 0xb0, 0x18, 0x44, 0x97, 0x90, 0x11, 0x17, 0x6b, 0x31, 0xc8, 0x1a, 0x09,
-	0x66, 0x05, 0x66, 0x6c, 0x86, 0x7b, 0x87, 0x05, 0x24, 0x66, 0x16, 0x39, 0xb1, 0x80, 0x0c, 0x0f,
-	0x82, 0x28, 0x55, 0x32, 0xe0, 0x62, 0x83, 0x08, 0x0b, 0x09, 0x70, 0x31, 0x67, 0xa7, 0x56, 0x82,
-	0x1d, 0xc6, 0x13, 0x04, 0x62, 0x0a, 0x89, 0x70, 0xb1, 0x96, 0x25, 0xe6, 0x94, 0xa6, 0x42, 0xed,
-	0x82, 0x70, 0x9c, 0xec, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x35,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x33, 0x3d, 0x2f, 0xb3, 0x24,
-	0x15, 0x1a, 0x5a, 0x15, 0x50, 0xba, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x38, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x81, 0xea, 0x54, 0x05, 0x92, 0x01, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x3e,
+	0x1c, 0xae, 0xd7, 0x97, 0xb5, 0x5e, 0xfe, 0x5b, 0xff, 0x56, 0x85, 0xb2, 0x32, 0x85, 0x52, 0x09,
+	0xa9, 0x20, 0x91, 0xb2, 0x72, 0xe2, 0x42, 0x45, 0x37, 0x89, 0x03, 0x12, 0x54, 0xa9, 0xb4, 0x03,
+	0x97, 0xc8, 0x4d, 0x4d, 0x66, 0x9a, 0xc4, 0x55, 0xec, 0x56, 0xec, 0x5b, 0x70, 0xe5, 0x1b, 0x70,
+	0xe4, 0xc0, 0x91, 0x0f, 0xb0, 0x1b, 0x13, 0x27, 0x4e, 0x08, 0xb5, 0x07, 0xbe, 0x06, 0xf2, 0x4b,
+	0x4a, 0x5f, 0x26, 0xd8, 0xc5, 0xf9, 0xbd, 0x3e, 0x8f, 0x1f, 0xff, 0xec, 0xc0, 0xa3, 0x30, 0x61,
+	0xb3, 0xb8, 0xad, 0xd7, 0xd9, 0x71, 0x3b, 0x24, 0x09, 0xe1, 0x94, 0xbb, 0x93, 0x94, 0x09, 0x86,
+	0xf6, 0x55, 0xdc, 0xd5, 0xeb, 0xec, 0xb8, 0xfe, 0x3f, 0x8e, 0x69, 0xc2, 0xda, 0x6a, 0xd5, 0x25,
+	0xf5, 0xc3, 0x80, 0xf1, 0x98, 0x71, 0x5f, 0x79, 0x6d, 0xed, 0x98, 0xd4, 0x26, 0xf6, 0x04, 0x07,
+	0x63, 0x1c, 0x12, 0x93, 0xbd, 0xbd, 0x95, 0x4d, 0x71, 0x9c, 0xb5, 0xd6, 0x42, 0x16, 0x32, 0x0d,
+	0x29, 0x2d, 0x1d, 0x6d, 0x7e, 0x28, 0x40, 0xeb, 0xb9, 0xde, 0xe0, 0x40, 0x60, 0x41, 0xd0, 0x13,
+	0x58, 0xd2, 0x6d, 0x36, 0x68, 0x80, 0xd6, 0x5e, 0xe7, 0x96, 0xbb, 0xbe, 0x61, 0xb7, 0xaf, 0xb2,
+	0xbd, 0xca, 0xe5, 0x8f, 0x3b, 0xb9, 0x8f, 0xbf, 0x3e, 0x3d, 0x00, 0x9e, 0x69, 0x40, 0x77, 0xa1,
+	0x95, 0x12, 0x1c, 0xc5, 0xbe, 0x01, 0xd8, 0x69, 0x80, 0x96, 0xe5, 0xed, 0xa9, 0x98, 0xee, 0x42,
+	0x1d, 0x58, 0xe4, 0x92, 0xc6, 0xce, 0x37, 0xf2, 0xd7, 0x81, 0xbf, 0x38, 0xeb, 0x63, 0x9a, 0xf6,
+	0x0a, 0x12, 0xdc, 0xd3, 0xa5, 0xe8, 0x3e, 0xac, 0x72, 0x31, 0x8a, 0xe8, 0x90, 0xfb, 0xc1, 0x39,
+	0x09, 0xc6, 0x7c, 0x1a, 0xdb, 0x85, 0x06, 0x68, 0x55, 0xbc, 0x03, 0x13, 0x3f, 0x31, 0x61, 0xf4,
+	0x0a, 0x56, 0xcd, 0x69, 0xfb, 0xe6, 0x64, 0xb8, 0x5d, 0x54, 0x4c, 0xce, 0x26, 0x93, 0x11, 0xdd,
+	0xd7, 0x65, 0x86, 0xf1, 0x20, 0x5c, 0x8b, 0x72, 0xf4, 0x14, 0x96, 0x97, 0x40, 0x25, 0x05, 0x74,
+	0xb4, 0x7d, 0x1e, 0x2a, 0xaf, 0x4e, 0xcf, 0xc0, 0x2c, 0x7b, 0xe4, 0xde, 0x33, 0xdb, 0x9f, 0x91,
+	0x94, 0x53, 0x96, 0xd8, 0xbb, 0x0d, 0xd0, 0xfa, 0xcf, 0x3b, 0xc8, 0xe2, 0x67, 0x3a, 0x8c, 0xba,
+	0x10, 0x26, 0x38, 0x26, 0x7c, 0x82, 0x03, 0xc2, 0xed, 0xb2, 0x22, 0x3b, 0xdc, 0x24, 0x7b, 0x99,
+	0x55, 0x18, 0xa6, 0x95, 0x16, 0x29, 0x9e, 0x0b, 0x96, 0xe2, 0x90, 0xf8, 0x23, 0x32, 0x61, 0x9c,
+	0x0a, 0x6e, 0x57, 0xae, 0x17, 0x3f, 0xd0, 0x75, 0xa7, 0xba, 0x2c, 0x13, 0xcf, 0xd7, 0xa2, 0xbc,
+	0xf9, 0x05, 0x40, 0x6b, 0x55, 0x1d, 0xb2, 0xe1, 0xae, 0xd9, 0xb5, 0xba, 0x1c, 0x96, 0x97, 0xb9,
+	0xa8, 0x06, 0x8b, 0x6a, 0xcc, 0x66, 0xe6, 0xda, 0x91, 0xf5, 0x6c, 0xf8, 0x96, 0x04, 0x82, 0xab,
+	0x79, 0x5b, 0x5e, 0xe6, 0xa2, 0x0e, 0xdc, 0x0d, 0x52, 0x82, 0x05, 0x4b, 0xf5, 0x28, 0x7b, 0xf6,
+	0xb7, 0xcf, 0x0f, 0x6b, 0xe6, 0xaa, 0x3f, 0x1b, 0x8d, 0x52, 0xc2, 0xf9, 0x40, 0xa4, 0x34, 0x09,
+	0xbd, 0xac, 0x10, 0xb9, 0xb0, 0x88, 0x47, 0x31, 0x4d, 0xec, 0xe2, 0x3f, 0x3a, 0x74, 0x59, 0xf3,
+	0x2b, 0x80, 0xfb, 0xeb, 0x53, 0x5e, 0xa5, 0x05, 0x37, 0xa5, 0x5d, 0x11, 0xbd, 0xb3, 0x2e, 0xba,
+	0x0b, 0x2b, 0x34, 0xa1, 0xc2, 0x0f, 0x70, 0x14, 0xd9, 0x79, 0xf5, 0x5a, 0x9a, 0x7f, 0xbf, 0x66,
+	0x27, 0x38, 0x8a, 0xbc, 0xb2, 0x6c, 0x92, 0xd6, 0x1f, 0x45, 0x85, 0x9b, 0x29, 0x3a, 0x85, 0x68,
+	0x1b, 0x0f, 0xd5, 0x61, 0xf9, 0xcd, 0x34, 0x09, 0x84, 0xbc, 0x5b, 0x4a, 0x95, 0xb7, 0xf4, 0x11,
+	0x82, 0x05, 0x9c, 0x86, 0xf2, 0x29, 0xe6, 0x5b, 0x15, 0x4f, 0xd9, 0xcd, 0x47, 0xb0, 0xa4, 0x9f,
+	0x19, 0xaa, 0xc2, 0xfc, 0x98, 0x5c, 0x98, 0x59, 0x4a, 0x53, 0xce, 0x71, 0x86, 0xa3, 0x69, 0x26,
+	0x55, 0x3b, 0xbd, 0xee, 0xe5, 0xdc, 0x01, 0x57, 0x73, 0x07, 0xfc, 0x9c, 0x3b, 0xe0, 0xfd, 0xc2,
+	0xc9, 0x5d, 0x2d, 0x9c, 0xdc, 0xf7, 0x85, 0x93, 0x7b, 0x7d, 0x2f, 0xa4, 0xe2, 0x7c, 0x3a, 0x74,
+	0x03, 0x16, 0xb7, 0x69, 0x98, 0x50, 0x41, 0xcc, 0xdf, 0xe7, 0x9d, 0xf9, 0x8a, 0x8b, 0x09, 0xe1,
+	0xc3, 0x92, 0xfa, 0xd9, 0x3c, 0xfe, 0x3d, 0x00, 0xce, 0x0d, 0xb4, 0x28, 0x1b, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -191,6 +497,74 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageDeposits) > 0 {
+		for iNdEx := len(m.StorageDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PackagesVersion != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PackagesVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Packages) > 0 {
+		for iNdEx := len(m.Packages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GenesisPackages) > 0 {
+		for iNdEx := len(m.GenesisPackages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GenesisPackages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.StdlibsChecksum) > 0 {
+		i -= len(m.StdlibsChecksum)
+		copy(dAtA[i:], m.StdlibsChecksum)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StdlibsChecksum)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.State) > 0 {
 		for iNdEx := len(m.State) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -225,7 +599,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *KVPair) Marshal() (dAtA []byte, err error) {
+func (m *PackageState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -235,68 +609,329 @@ func (m *KVPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KVPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *PackageState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PackageState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Objects) > 0 {
+		for iNdEx := len(m.Objects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Objects[iNdEx])
+			copy(dAtA[i:], m.Objects[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Objects[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Realm) > 0 {
+		i -= len(m.Realm)
+		copy(dAtA[i:], m.Realm)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Realm)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
+	if len(m.Package) > 0 {
+		i -= len(m.Package)
+		copy(dAtA[i:], m.Package)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Package)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GenesisPackage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GenesisPackage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPackage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.RealmParams)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.State) > 0 {
-		for _, e := range m.State {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.InitCall != nil {
+		{
+			size, err := m.InitCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Package) > 0 {
+		i -= len(m.Package)
+		copy(dAtA[i:], m.Package)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Package)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *KVPair) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisPackageCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisPackageCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPackageCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Function) > 0 {
+		i -= len(m.Function)
+		copy(dAtA[i:], m.Function)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Function)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KVPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KVPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.RealmParams)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.State) > 0 {
+		for _, e := range m.State {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.StdlibsChecksum)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.GenesisPackages) > 0 {
+		for _, e := range m.GenesisPackages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Packages) > 0 {
+		for _, e := range m.Packages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PackagesVersion != 0 {
+		n += 1 + sovGenesis(uint64(m.PackagesVersion))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StorageDeposits) > 0 {
+		for _, e := range m.StorageDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PackageState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Realm)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Objects) > 0 {
+		for _, b := range m.Objects {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisPackage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InitCall != nil {
+		l = m.InitCall.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisPackageCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Function)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KVPair) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -447,6 +1082,705 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdlibsChecksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StdlibsChecksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisPackages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisPackages = append(m.GenesisPackages, GenesisPackage{})
+			if err := m.GenesisPackages[len(m.GenesisPackages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packages = append(m.Packages, PackageState{})
+			if err := m.Packages[len(m.Packages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackagesVersion", wireType)
+			}
+			m.PackagesVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackagesVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, Namespace{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDeposits = append(m.StorageDeposits, StorageDeposit{})
+			if err := m.StorageDeposits[len(m.StorageDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PackageState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PackageState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PackageState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Package = append(m.Package[:0], dAtA[iNdEx:postIndex]...)
+			if m.Package == nil {
+				m.Package = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Realm = append(m.Realm[:0], dAtA[iNdEx:postIndex]...)
+			if m.Realm == nil {
+				m.Realm = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, make([]byte, postIndex-iNdEx))
+			copy(m.Objects[len(m.Objects)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisPackage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisPackage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisPackage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Package = append(m.Package[:0], dAtA[iNdEx:postIndex]...)
+			if m.Package == nil {
+				m.Package = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitCall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitCall == nil {
+				m.InitCall = &GenesisPackageCall{}
+			}
+			if err := m.InitCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisPackageCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisPackageCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisPackageCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Function = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
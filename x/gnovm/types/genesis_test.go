@@ -3,11 +3,23 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
 	"github.com/ignite/gnovm/x/gnovm/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+	mpkg := &std.MemPackage{
+		Type:  "MPUserProd",
+		Name:  "counter",
+		Path:  "gno.land/r/demo/counter",
+		Files: []*std.MemFile{{Name: "counter.gno", Body: "package counter\n"}},
+	}
+	pkg, err := types.NewGenesisPackage(creator, mpkg, nil)
+	require.NoError(t, err)
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -18,6 +30,185 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "valid stdlibs checksum",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				StdlibsChecksum: "a3c1b5a8d1e1fbd3e46fd7b4b4d0c8b6b2f3c7e1d8f6e0a9b1c2d3e4f5a6b7c8",
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid stdlibs checksum",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				StdlibsChecksum: "not-a-checksum",
+			},
+			valid: false,
+		},
+		{
+			desc: "valid packages",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				Packages:        []types.PackageState{{Package: pkg.Package, Creator: creator}},
+				PackagesVersion: types.PackagesVersion,
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid package state creator",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				Packages:        []types.PackageState{{Package: pkg.Package, Creator: "invalid"}},
+				PackagesVersion: types.PackagesVersion,
+			},
+			valid: false,
+		},
+		{
+			desc: "unsupported packages version",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				Packages:        []types.PackageState{{Package: pkg.Package}},
+				PackagesVersion: types.PackagesVersion + 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "both packages and state",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				Packages:        []types.PackageState{{Package: pkg.Package}},
+				PackagesVersion: types.PackagesVersion,
+				State:           []types.KVPair{{Key: []byte("key"), Value: []byte("value")}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid package state",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				Packages:        []types.PackageState{{Package: []byte("{}")}},
+				PackagesVersion: types.PackagesVersion,
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis packages",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				GenesisPackages: []types.GenesisPackage{pkg},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate genesis packages",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				GenesisPackages: []types.GenesisPackage{pkg, pkg},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis package creator",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				GenesisPackages: []types.GenesisPackage{{Creator: "invalid", Package: pkg.Package}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis package",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				GenesisPackages: []types.GenesisPackage{{Creator: creator, Package: []byte("{}")}},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty genesis package init call",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				GenesisPackages: []types.GenesisPackage{{Creator: creator, Package: pkg.Package, InitCall: &types.GenesisPackageCall{}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "namespaces",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				Namespaces: []types.Namespace{{Name: "demo", Owner: creator}, {Name: "team", Owner: creator}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate namespaces",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				Namespaces: []types.Namespace{{Name: "demo", Owner: creator}, {Name: "demo", Owner: creator}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid namespace owner",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				Namespaces: []types.Namespace{{Name: "demo", Owner: "invalid"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "storage deposits",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				StorageDeposits: []types.StorageDeposit{
+					{PkgPath: "gno.land/r/demo/counter", Payer: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate storage deposits",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				StorageDeposits: []types.StorageDeposit{
+					{PkgPath: "gno.land/r/demo/counter", Payer: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+					{PkgPath: "gno.land/r/demo/counter", Payer: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "storage deposit of a pure package",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				StorageDeposits: []types.StorageDeposit{
+					{PkgPath: "gno.land/p/demo/greet", Payer: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty storage deposit",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				StorageDeposits: []types.StorageDeposit{{PkgPath: "gno.land/r/demo/counter", Payer: creator}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid reserved namespace",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					SysnamesPkgpath:     types.DefaultParams().SysnamesPkgpath,
+					ChainDomain:         types.DefaultParams().ChainDomain,
+					DefaultDeposit:      types.DefaultParams().DefaultDeposit,
+					StoragePrice:        types.DefaultParams().StoragePrice,
+					StorageFeeCollector: types.DefaultParams().StorageFeeCollector,
+					ReservedNamespaces:  []string{"Sys"},
+				},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -30,3 +221,16 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParseExportFormat(t *testing.T) {
+	format, err := types.ParseExportFormat("")
+	require.NoError(t, err)
+	require.Equal(t, types.ExportFormatKV, format)
+
+	format, err = types.ParseExportFormat("packages")
+	require.NoError(t, err)
+	require.Equal(t, types.ExportFormatPackages, format)
+
+	_, err = types.ParseExportFormat("json")
+	require.Error(t, err)
+}
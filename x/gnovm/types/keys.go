@@ -14,3 +14,15 @@ var (
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_gnovm")
+
+// StdlibsChecksumKey is the prefix to retrieve the checksum of the stdlibs loaded at genesis
+var StdlibsChecksumKey = collections.NewPrefix("s_gnovm_stdlibs")
+
+// PackagesKey is the prefix to retrieve the index of the deployed packages
+var PackagesKey = collections.NewPrefix("i_gnovm_packages")
+
+// NamespacesKey is the prefix to retrieve the claimed namespaces
+var NamespacesKey = collections.NewPrefix("n_gnovm_namespaces")
+
+// StorageDepositsKey is the prefix to retrieve the storage deposits locked by each payer
+var StorageDepositsKey = collections.NewPrefix("d_gnovm_storage_deposits")
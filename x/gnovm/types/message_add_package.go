@@ -1,6 +1,13 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
 
 func NewMsgAddPackage(creator string, send, maxDeposit sdk.Coins, pkg []byte) *MsgAddPackage {
 	return &MsgAddPackage{
@@ -10,3 +17,39 @@ func NewMsgAddPackage(creator string, send, maxDeposit sdk.Coins, pkg []byte) *M
 		Package:    pkg,
 	}
 }
+
+func NewMsgAddPackages(creator string, maxDeposit sdk.Coins, mpkgs []*std.MemPackage) (*MsgAddPackages, error) {
+	pkgs := make([][]byte, len(mpkgs))
+	for i, mpkg := range mpkgs {
+		bz, err := json.Marshal(mpkg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal package %s: %w", mpkg.Path, err)
+		}
+		pkgs[i] = bz
+	}
+
+	return &MsgAddPackages{
+		Creator:    creator,
+		MaxDeposit: maxDeposit,
+		Packages:   pkgs,
+	}, nil
+}
+
+// MemPackages decodes the packages of the message and returns them in
+// deployment order.
+func (msg *MsgAddPackages) MemPackages() ([]*std.MemPackage, error) {
+	if len(msg.Packages) == 0 {
+		return nil, fmt.Errorf("no packages to add")
+	}
+
+	mpkgs := make([]*std.MemPackage, len(msg.Packages))
+	for i, bz := range msg.Packages {
+		mpkg, err := decodeMemPackage(bz)
+		if err != nil {
+			return nil, fmt.Errorf("package #%d: %w", i, err)
+		}
+		mpkgs[i] = mpkg
+	}
+
+	return SortPackages(mpkgs)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewMsgUpgradePackage(sender string, maxDeposit sdk.Coins, pkg []byte) *MsgUpgradePackage {
+	return &MsgUpgradePackage{
+		Sender:     sender,
+		MaxDeposit: maxDeposit,
+		Package:    pkg,
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+)
+
+// Validate validates the mint permission of a realm.
+func (p MintPermission) Validate() error {
+	if !gno.IsRealmPath(p.PkgPath) {
+		return fmt.Errorf("invalid mint permission realm %q", p.PkgPath)
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid mint permission denom: %w", err)
+	}
+	// the VM only lets realms issue the denoms prefixed by their path
+	if pkgPath, _, ok := ParseRealmDenom(p.Denom); !ok || pkgPath != p.PkgPath {
+		return fmt.Errorf("denom %s cannot be issued by %s, expected %s", p.Denom, p.PkgPath, RealmDenom(p.PkgPath, "<symbol>"))
+	}
+	if !p.SupplyCap.IsNil() && p.SupplyCap.IsNegative() {
+		return fmt.Errorf("supply cap of %s cannot be negative", p.Denom)
+	}
+
+	return nil
+}
+
+// HasSupplyCap returns whether the supply of the denom is capped.
+func (p MintPermission) HasSupplyCap() bool {
+	return !p.SupplyCap.IsNil() && p.SupplyCap.IsPositive()
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxNamespaceLength is the maximum length of a namespace name.
+const maxNamespaceLength = 64
+
+var reNamespace = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// ValidateNamespace validates the name of a namespace.
+func ValidateNamespace(name string) error {
+	if len(name) > maxNamespaceLength {
+		return fmt.Errorf("namespace %s is longer than %d characters", name, maxNamespaceLength)
+	}
+	if !reNamespace.MatchString(name) {
+		return fmt.Errorf("invalid namespace %q, it must start with a lowercase letter followed by lowercase letters, digits or underscores", name)
+	}
+
+	return nil
+}
+
+// PackageNamespace returns the namespace of the package path, i.e. its first
+// element after the realm or pure prefix of the chain domain. It returns an
+// empty string for the paths without namespace, e.g. the stdlibs.
+func PackageNamespace(chainDomain, pkgPath string) string {
+	for _, prefix := range []string{chainDomain + "/r/", chainDomain + "/p/"} {
+		if rest, ok := strings.CutPrefix(pkgPath, prefix); ok {
+			name, _, _ := strings.Cut(rest, "/")
+			return name
+		}
+	}
+
+	return ""
+}
+
+// IsReservedNamespace returns whether the namespace is reserved to the module
+// authority.
+func (p Params) IsReservedNamespace(name string) bool {
+	return slices.Contains(p.ReservedNamespaces, name)
+}
+
+// Validate performs basic validation of the namespace.
+func (ns Namespace) Validate() error {
+	if err := ValidateNamespace(ns.Name); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(ns.Owner); err != nil {
+		return fmt.Errorf("invalid owner of namespace %s: %w", ns.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestPackageNamespace(t *testing.T) {
+	tests := map[string]string{
+		"gno.land/r/demo/counter":   "demo",
+		"gno.land/p/demo/avl/node":  "demo",
+		"gno.land/r/sys":            "sys",
+		"gno.land/e/cosmos1abc/run": "",
+		"other.land/r/demo/foo":     "",
+		"strings":                   "",
+	}
+	for pkgPath, expected := range tests {
+		require.Equal(t, expected, types.PackageNamespace("gno.land", pkgPath), pkgPath)
+	}
+}
+
+func TestValidateNamespace(t *testing.T) {
+	for _, name := range []string{"demo", "my_team2", "cosmos1qhq6hqzqv3u2nks4mgz9pmwhckw6hznkzwxlec"} {
+		require.NoError(t, types.ValidateNamespace(name), name)
+	}
+	for _, name := range []string{"", "Demo", "2team", "_team", "my-team", "a/b", strings.Repeat("a", 65)} {
+		require.Error(t, types.ValidateNamespace(name), name)
+	}
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gnolang/gno/gnovm/pkg/packages"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// SortPackages sorts the packages so that each package comes after the
+// packages it imports, keeping the given order of independent packages.
+// The imports of packages which are not part of the list are ignored.
+func SortPackages(mpkgs []*std.MemPackage) ([]*std.MemPackage, error) {
+	byPath := make(map[string]*std.MemPackage, len(mpkgs))
+	for _, mpkg := range mpkgs {
+		if _, ok := byPath[mpkg.Path]; ok {
+			return nil, fmt.Errorf("duplicate package %s", mpkg.Path)
+		}
+		byPath[mpkg.Path] = mpkg
+	}
+
+	deps := make(map[string][]string, len(mpkgs))
+	for _, mpkg := range mpkgs {
+		imports, err := packages.Imports(mpkg, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid imports of package %s: %w", mpkg.Path, err)
+		}
+		kinds := []packages.FileKind{packages.FileKindPackageSource, packages.FileKindTest, packages.FileKindXTest}
+		for _, im := range imports.Merge(kinds...) {
+			if _, ok := byPath[im.PkgPath]; ok && im.PkgPath != mpkg.Path {
+				deps[mpkg.Path] = append(deps[mpkg.Path], im.PkgPath)
+			}
+		}
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	var (
+		sorted = make([]*std.MemPackage, 0, len(mpkgs))
+		state  = make(map[string]int, len(mpkgs))
+		stack  []string
+		visit  func(path string) error
+	)
+	visit = func(path string) error {
+		switch state[path] {
+		case visited:
+			return nil
+		case visiting:
+			stack = append(stack, path)
+			for i, p := range stack {
+				if p == path {
+					stack = stack[i:]
+					break
+				}
+			}
+			return fmt.Errorf("import cycle between packages: %s", strings.Join(stack, " -> "))
+		}
+
+		state[path] = visiting
+		stack = append(stack, path)
+		for _, dep := range deps[path] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[path] = visited
+		sorted = append(sorted, byPath[path])
+
+		return nil
+	}
+
+	for _, mpkg := range mpkgs {
+		if err := visit(mpkg.Path); err != nil {
+			return nil, err
+		}
+	}
+
+	return sorted, nil
+}
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestSortPackages(t *testing.T) {
+	pkg := func(path string, imports ...string) *std.MemPackage {
+		body := "package p\n"
+		for _, im := range imports {
+			body += "\nimport _ \"" + im + "\"\n"
+		}
+		return &std.MemPackage{
+			Name:  "p",
+			Path:  path,
+			Files: []*std.MemFile{{Name: "p.gno", Body: body}},
+		}
+	}
+
+	tests := []struct {
+		desc     string
+		pkgs     []*std.MemPackage
+		expected []string
+		err      string
+	}{
+		{
+			desc:     "independent packages keep their order",
+			pkgs:     []*std.MemPackage{pkg("gno.land/p/b"), pkg("gno.land/p/a")},
+			expected: []string{"gno.land/p/b", "gno.land/p/a"},
+		},
+		{
+			desc: "imported packages come first",
+			pkgs: []*std.MemPackage{
+				pkg("gno.land/r/app", "gno.land/p/b", "gno.land/p/a"),
+				pkg("gno.land/p/b", "gno.land/p/a", "strings"),
+				pkg("gno.land/p/a", "gno.land/p/external"),
+			},
+			expected: []string{"gno.land/p/a", "gno.land/p/b", "gno.land/r/app"},
+		},
+		{
+			desc: "test imports",
+			pkgs: []*std.MemPackage{
+				{
+					Name: "p",
+					Path: "gno.land/p/b",
+					Files: []*std.MemFile{
+						{Name: "p.gno", Body: "package p\n"},
+						{Name: "p_test.gno", Body: "package p\n\nimport _ \"gno.land/p/a\"\n"},
+					},
+				},
+				pkg("gno.land/p/a"),
+			},
+			expected: []string{"gno.land/p/a", "gno.land/p/b"},
+		},
+		{
+			desc: "import cycle",
+			pkgs: []*std.MemPackage{
+				pkg("gno.land/p/a", "gno.land/p/b"),
+				pkg("gno.land/p/b", "gno.land/p/c"),
+				pkg("gno.land/p/c", "gno.land/p/b"),
+			},
+			err: "import cycle between packages: gno.land/p/b -> gno.land/p/c -> gno.land/p/b",
+		},
+		{
+			desc: "duplicate package",
+			pkgs: []*std.MemPackage{pkg("gno.land/p/a"), pkg("gno.land/p/a")},
+			err:  "duplicate package gno.land/p/a",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			sorted, err := types.SortPackages(tc.pkgs)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			paths := make([]string, len(sorted))
+			for i, mpkg := range sorted {
+				paths[i] = mpkg.Path
+			}
+			require.Equal(t, tc.expected, paths)
+		})
+	}
+}
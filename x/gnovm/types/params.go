@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -15,6 +17,8 @@ var (
 	// per operation in the SDK and to avoid double counting.
 	defaultStorageCost int64 = 1
 	moduleAccountAddr        = authtypes.NewModuleAddress(ModuleName)
+	// defaultReservedNamespaces are the namespaces of the system realms.
+	defaultReservedNamespaces = []string{"sys"}
 )
 
 // DefaultParams returns the default set of parameters.
@@ -26,11 +30,57 @@ func DefaultParams() Params {
 		DefaultDeposit:      sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(defaultDepositCost)).String(),
 		StoragePrice:        sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(defaultStorageCost)).String(),
 		StorageFeeCollector: moduleAccountAddr,
+		ReservedNamespaces:  defaultReservedNamespaces,
 	}
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	for _, name := range p.ReservedNamespaces {
+		if err := ValidateNamespace(name); err != nil {
+			return fmt.Errorf("invalid reserved namespace: %w", err)
+		}
+	}
+
+	if _, ok := DeploymentPolicy_name[int32(p.DeploymentPolicy)]; !ok {
+		return fmt.Errorf("invalid deployment policy %d", p.DeploymentPolicy)
+	}
+	if len(p.DeploymentAllowlist) > 0 && p.DeploymentPolicy != DeploymentPolicy_DEPLOYMENT_POLICY_ALLOWLIST {
+		return fmt.Errorf("deployment allowlist is only used by the %s deployment policy", DeploymentPolicy_DEPLOYMENT_POLICY_ALLOWLIST)
+	}
+	allowlist := make(map[string]struct{}, len(p.DeploymentAllowlist))
+	for _, addr := range p.DeploymentAllowlist {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid deployment allowlist address %s: %w", addr, err)
+		}
+		if _, ok := allowlist[addr]; ok {
+			return fmt.Errorf("duplicate deployment allowlist address %s", addr)
+		}
+		allowlist[addr] = struct{}{}
+	}
+
+	restrictedDenoms := make(map[string]struct{}, len(p.RestrictedDenoms))
+	for _, denom := range p.RestrictedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid restricted denom: %w", err)
+		}
+		if _, ok := restrictedDenoms[denom]; ok {
+			return fmt.Errorf("duplicate restricted denom %s", denom)
+		}
+		restrictedDenoms[denom] = struct{}{}
+	}
+
+	mintDenoms := make(map[string]struct{}, len(p.MintPermissions))
+	for _, perm := range p.MintPermissions {
+		if err := perm.Validate(); err != nil {
+			return err
+		}
+		if _, ok := mintDenoms[perm.Denom]; ok {
+			return fmt.Errorf("duplicate mint permission denom %s", perm.Denom)
+		}
+		mintDenoms[perm.Denom] = struct{}{}
+	}
+
 	return p.ToVmParams().Validate()
 }
 
@@ -47,7 +97,20 @@ func (p Params) ToVmParams() vm.Params {
 	return vmParams
 }
 
-// VmParamsToParams converts the vm.Params to Params.
+// WithVmParams returns the params with the vm.Params replaced by the given ones.
+func (p Params) WithVmParams(vmParams vm.Params) Params {
+	params := VmParamsToParams(vmParams)
+	params.ReservedNamespaces = p.ReservedNamespaces
+	params.DeploymentPolicy = p.DeploymentPolicy
+	params.DeploymentAllowlist = p.DeploymentAllowlist
+	params.RestrictedDenoms = p.RestrictedDenoms
+	params.MintPermissions = p.MintPermissions
+
+	return params
+}
+
+// VmParamsToParams converts the vm.Params to Params. The module only params,
+// e.g. the reserved namespaces, are left empty.
 func VmParamsToParams(vmParams vm.Params) Params {
 	return Params{
 		SysnamesPkgpath:     vmParams.SysNamesPkgPath,
@@ -5,7 +5,9 @@ package types
 
 import (
 	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -25,6 +27,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DeploymentPolicy defines who can add packages. The module authority can
+// always add packages.
+type DeploymentPolicy int32
+
+const (
+	// DEPLOYMENT_POLICY_OPEN allows any account to add packages.
+	DeploymentPolicy_DEPLOYMENT_POLICY_OPEN DeploymentPolicy = 0
+	// DEPLOYMENT_POLICY_ALLOWLIST allows the accounts of the deployment
+	// allowlist to add packages.
+	DeploymentPolicy_DEPLOYMENT_POLICY_ALLOWLIST DeploymentPolicy = 1
+	// DEPLOYMENT_POLICY_GOV_ONLY only allows the module authority to add
+	// packages, i.e. packages are added by governance proposals.
+	DeploymentPolicy_DEPLOYMENT_POLICY_GOV_ONLY DeploymentPolicy = 2
+)
+
+var DeploymentPolicy_name = map[int32]string{
+	0: "DEPLOYMENT_POLICY_OPEN",
+	1: "DEPLOYMENT_POLICY_ALLOWLIST",
+	2: "DEPLOYMENT_POLICY_GOV_ONLY",
+}
+
+var DeploymentPolicy_value = map[string]int32{
+	"DEPLOYMENT_POLICY_OPEN":      0,
+	"DEPLOYMENT_POLICY_ALLOWLIST": 1,
+	"DEPLOYMENT_POLICY_GOV_ONLY":  2,
+}
+
+func (x DeploymentPolicy) String() string {
+	return proto.EnumName(DeploymentPolicy_name, int32(x))
+}
+
+func (DeploymentPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_564dc0d00d767058, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	SysnamesPkgpath     string `protobuf:"bytes,1,opt,name=sysnames_pkgpath,json=sysnamesPkgpath,proto3" json:"sysnames_pkgpath,omitempty"`
@@ -32,6 +69,20 @@ type Params struct {
 	DefaultDeposit      string `protobuf:"bytes,3,opt,name=default_deposit,json=defaultDeposit,proto3" json:"default_deposit,omitempty"`
 	StoragePrice        string `protobuf:"bytes,4,opt,name=storage_price,json=storagePrice,proto3" json:"storage_price,omitempty"`
 	StorageFeeCollector []byte `protobuf:"bytes,5,opt,name=storage_fee_collector,json=storageFeeCollector,proto3" json:"storage_fee_collector,omitempty"`
+	// reserved_namespaces are the namespaces owned by the module authority,
+	// which cannot be claimed by accounts.
+	ReservedNamespaces []string `protobuf:"bytes,6,rep,name=reserved_namespaces,json=reservedNamespaces,proto3" json:"reserved_namespaces,omitempty"`
+	// deployment_policy defines who can add packages.
+	DeploymentPolicy DeploymentPolicy `protobuf:"varint,7,opt,name=deployment_policy,json=deploymentPolicy,proto3,enum=gnovm.gnovm.v1.DeploymentPolicy" json:"deployment_policy,omitempty"`
+	// deployment_allowlist are the addresses allowed to add packages with the
+	// allowlist deployment policy.
+	DeploymentAllowlist []string `protobuf:"bytes,8,rep,name=deployment_allowlist,json=deploymentAllowlist,proto3" json:"deployment_allowlist,omitempty"`
+	// restricted_denoms are the denoms which cannot be transferred through the
+	// VM, neither by realms nor sent to them. They are still used to pay the
+	// storage deposits.
+	RestrictedDenoms []string `protobuf:"bytes,9,rep,name=restricted_denoms,json=restrictedDenoms,proto3" json:"restricted_denoms,omitempty"`
+	// mint_permissions are the denoms which realms are allowed to issue.
+	MintPermissions []MintPermission `protobuf:"bytes,10,rep,name=mint_permissions,json=mintPermissions,proto3" json:"mint_permissions"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -102,34 +153,150 @@ func (m *Params) GetStorageFeeCollector() []byte {
 	return nil
 }
 
+func (m *Params) GetReservedNamespaces() []string {
+	if m != nil {
+		return m.ReservedNamespaces
+	}
+	return nil
+}
+
+func (m *Params) GetDeploymentPolicy() DeploymentPolicy {
+	if m != nil {
+		return m.DeploymentPolicy
+	}
+	return DeploymentPolicy_DEPLOYMENT_POLICY_OPEN
+}
+
+func (m *Params) GetDeploymentAllowlist() []string {
+	if m != nil {
+		return m.DeploymentAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetRestrictedDenoms() []string {
+	if m != nil {
+		return m.RestrictedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMintPermissions() []MintPermission {
+	if m != nil {
+		return m.MintPermissions
+	}
+	return nil
+}
+
+// MintPermission allows a realm to issue the coins of one of its denoms.
+type MintPermission struct {
+	// pkg_path is the path of the realm issuing the coins.
+	PkgPath string `protobuf:"bytes,1,opt,name=pkg_path,json=pkgPath,proto3" json:"pkg_path,omitempty"`
+	// denom is the bank denom of the coins, i.e. gno/<pkg_path>/<symbol>.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// supply_cap is the maximum total supply of the denom, zero for no cap.
+	SupplyCap cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=supply_cap,json=supplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"supply_cap"`
+}
+
+func (m *MintPermission) Reset()         { *m = MintPermission{} }
+func (m *MintPermission) String() string { return proto.CompactTextString(m) }
+func (*MintPermission) ProtoMessage()    {}
+func (*MintPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_564dc0d00d767058, []int{1}
+}
+func (m *MintPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintPermission.Merge(m, src)
+}
+func (m *MintPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintPermission proto.InternalMessageInfo
+
+func (m *MintPermission) GetPkgPath() string {
+	if m != nil {
+		return m.PkgPath
+	}
+	return ""
+}
+
+func (m *MintPermission) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("gnovm.gnovm.v1.DeploymentPolicy", DeploymentPolicy_name, DeploymentPolicy_value)
 	proto.RegisterType((*Params)(nil), "gnovm.gnovm.v1.Params")
+	proto.RegisterType((*MintPermission)(nil), "gnovm.gnovm.v1.MintPermission")
 }
 
 func init() { proto.RegisterFile("gnovm/gnovm/v1/params.proto", fileDescriptor_564dc0d00d767058) }
 
 var fileDescriptor_564dc0d00d767058 = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0x32, 0x41,
-	0x10, 0xc7, 0xd9, 0xef, 0x53, 0x12, 0x57, 0x04, 0x3d, 0x31, 0xb9, 0x60, 0x3c, 0x51, 0x63, 0x44,
-	0x0b, 0x2e, 0x68, 0x67, 0x63, 0xa2, 0xc4, 0x9a, 0x50, 0xda, 0x5c, 0x96, 0x63, 0x58, 0x36, 0xb2,
-	0x3b, 0x9b, 0xdb, 0x85, 0xc8, 0x2b, 0x58, 0xf9, 0x08, 0x3e, 0x82, 0x8f, 0x61, 0x49, 0x69, 0x69,
-	0xa0, 0xd0, 0x37, 0xb0, 0x35, 0xec, 0x1e, 0x85, 0xcd, 0x3f, 0x93, 0xdf, 0xfc, 0x32, 0xc9, 0xfc,
-	0xe9, 0x3e, 0x57, 0x38, 0x91, 0xb1, 0xcf, 0x49, 0x2b, 0xd6, 0x2c, 0x63, 0xd2, 0x34, 0x75, 0x86,
-	0x16, 0x83, 0xb2, 0xc3, 0x4d, 0x9f, 0x93, 0x56, 0x6d, 0x87, 0x49, 0xa1, 0x30, 0x76, 0xe9, 0x95,
-	0x5a, 0x95, 0x23, 0x47, 0x37, 0xc6, 0xcb, 0xc9, 0xd3, 0xe3, 0x1f, 0x42, 0x8b, 0x1d, 0x77, 0x29,
-	0x38, 0xa7, 0xdb, 0x66, 0x6a, 0x14, 0x93, 0x60, 0x12, 0xfd, 0xc8, 0x35, 0xb3, 0xc3, 0x90, 0xd4,
-	0x49, 0x63, 0xa3, 0x5b, 0x59, 0xf1, 0x8e, 0xc7, 0xc1, 0x11, 0x2d, 0xa5, 0x43, 0x26, 0x54, 0xd2,
-	0x47, 0xc9, 0x84, 0x0a, 0xff, 0x39, 0x6d, 0xd3, 0xb1, 0xb6, 0x43, 0xc1, 0x19, 0xad, 0xf4, 0x61,
-	0xc0, 0xc6, 0x23, 0x9b, 0xf4, 0x41, 0xa3, 0x11, 0x36, 0xfc, 0xef, 0xac, 0x72, 0x8e, 0xdb, 0x9e,
-	0x06, 0x27, 0x74, 0xcb, 0x58, 0xcc, 0x18, 0x87, 0x44, 0x67, 0x22, 0x85, 0x70, 0xcd, 0x69, 0xa5,
-	0x1c, 0x76, 0x96, 0x2c, 0xb8, 0xa4, 0x7b, 0x2b, 0x69, 0x00, 0x90, 0xa4, 0x38, 0x1a, 0x41, 0x6a,
-	0x31, 0x0b, 0xd7, 0xeb, 0xa4, 0x51, 0xea, 0xee, 0xe6, 0xcb, 0x7b, 0x80, 0xbb, 0xd5, 0xea, 0xfa,
-	0xe0, 0xfb, 0xf5, 0x90, 0x3c, 0x7f, 0xbd, 0x5d, 0x54, 0x7d, 0x67, 0x4f, 0x79, 0x77, 0xfe, 0xdd,
-	0xdb, 0x9b, 0xf7, 0x79, 0x44, 0x66, 0xf3, 0x88, 0x7c, 0xce, 0x23, 0xf2, 0xb2, 0x88, 0x0a, 0xb3,
-	0x45, 0x54, 0xf8, 0x58, 0x44, 0x85, 0x87, 0x53, 0x2e, 0xec, 0x70, 0xdc, 0x6b, 0xa6, 0x28, 0x63,
-	0xc1, 0x95, 0xb0, 0x10, 0xff, 0xbd, 0x60, 0xa7, 0x1a, 0x4c, 0xaf, 0xe8, 0x1a, 0xbc, 0xfa, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0xce, 0x1e, 0xd7, 0x0b, 0x99, 0x01, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x63, 0xfe, 0x67, 0xe0, 0x06, 0x33, 0x84, 0x2b, 0x13, 0x74, 0x9d, 0x5c, 0xaa, 0xaa,
+	0x29, 0x88, 0x58, 0xd0, 0x1d, 0x9b, 0x8a, 0x10, 0x5a, 0xa5, 0x0d, 0x89, 0x15, 0x50, 0x2b, 0xba,
+	0xb1, 0x06, 0x7b, 0x70, 0x46, 0xb1, 0x3d, 0x23, 0xcf, 0x90, 0x36, 0xaf, 0xd0, 0x55, 0xa5, 0xbe,
+	0x40, 0x97, 0x5d, 0xb2, 0xe0, 0x1d, 0xca, 0x12, 0xb1, 0xaa, 0xba, 0x40, 0x15, 0x2c, 0xe8, 0x63,
+	0x54, 0x9e, 0x71, 0x80, 0xc0, 0x66, 0x94, 0xf9, 0x7d, 0xdf, 0x4c, 0xce, 0x39, 0xf3, 0x19, 0x2c,
+	0xf9, 0x11, 0xed, 0x85, 0x96, 0x5a, 0x7b, 0xeb, 0x16, 0x43, 0x31, 0x0a, 0x79, 0x85, 0xc5, 0x54,
+	0x50, 0x98, 0x93, 0xb8, 0xa2, 0xd6, 0xde, 0x7a, 0x61, 0x0e, 0x85, 0x24, 0xa2, 0x96, 0x5c, 0x95,
+	0xa5, 0xb0, 0xe8, 0x52, 0x1e, 0x52, 0xee, 0xc8, 0x9d, 0xa5, 0x36, 0xa9, 0x94, 0xf7, 0xa9, 0x4f,
+	0x15, 0x4f, 0x7e, 0x29, 0xba, 0xfc, 0x63, 0x0c, 0x4c, 0xd8, 0xf2, 0x4f, 0xe0, 0x73, 0xa0, 0xf3,
+	0x3e, 0x8f, 0x50, 0x88, 0xb9, 0xc3, 0xba, 0x3e, 0x43, 0xa2, 0x63, 0x68, 0x25, 0xad, 0x9c, 0x6d,
+	0xcf, 0x0e, 0xb8, 0xad, 0x30, 0xfc, 0x1f, 0xcc, 0xb8, 0x1d, 0x44, 0x22, 0xc7, 0xa3, 0x21, 0x22,
+	0x91, 0x31, 0x22, 0x6d, 0xd3, 0x92, 0xd5, 0x24, 0x82, 0xcf, 0xc0, 0xac, 0x87, 0x8f, 0xd0, 0x71,
+	0x20, 0x1c, 0x0f, 0x33, 0xca, 0x89, 0x30, 0x46, 0xa5, 0x2b, 0x97, 0xe2, 0x9a, 0xa2, 0xf0, 0x09,
+	0xf8, 0x87, 0x0b, 0x1a, 0x23, 0x1f, 0x3b, 0x2c, 0x26, 0x2e, 0x36, 0xc6, 0xa4, 0x6d, 0x26, 0x85,
+	0x76, 0xc2, 0xe0, 0x06, 0x58, 0x18, 0x98, 0x8e, 0x30, 0x76, 0x5c, 0x1a, 0x04, 0xd8, 0x15, 0x34,
+	0x36, 0xc6, 0x4b, 0x5a, 0x79, 0xa6, 0x3d, 0x9f, 0x8a, 0xaf, 0x30, 0xde, 0x1e, 0x48, 0xd0, 0x02,
+	0xf3, 0x31, 0xe6, 0x38, 0xee, 0x61, 0xcf, 0x91, 0xd5, 0x33, 0xe4, 0x62, 0x6e, 0x4c, 0x94, 0x46,
+	0xcb, 0xd9, 0x36, 0x1c, 0x48, 0xcd, 0x5b, 0x05, 0xee, 0x82, 0x39, 0x0f, 0xb3, 0x80, 0xf6, 0x43,
+	0x1c, 0x09, 0x87, 0xd1, 0x80, 0xb8, 0x7d, 0x63, 0xb2, 0xa4, 0x95, 0x73, 0x1b, 0xa5, 0xca, 0xf0,
+	0xec, 0x2b, 0xb5, 0x5b, 0xa3, 0x2d, 0x7d, 0x6d, 0xdd, 0x7b, 0x40, 0xe0, 0x5b, 0x90, 0xbf, 0x77,
+	0x1d, 0x0a, 0x02, 0xfa, 0x31, 0x20, 0x5c, 0x18, 0x53, 0x49, 0x01, 0x55, 0xe3, 0xe2, 0x74, 0x2d,
+	0x9f, 0x3e, 0xd0, 0x96, 0xe7, 0xc5, 0x98, 0xf3, 0x3d, 0x11, 0x93, 0xc8, 0x6f, 0xcf, 0xdf, 0x9d,
+	0xda, 0x1a, 0x1c, 0x82, 0xab, 0x60, 0x2e, 0xc6, 0x5c, 0xc4, 0xc4, 0x15, 0xd8, 0x73, 0x3c, 0x1c,
+	0xd1, 0x90, 0x1b, 0x59, 0xd9, 0x8a, 0x7e, 0x27, 0xd4, 0x24, 0x87, 0xfb, 0x40, 0x0f, 0x49, 0xd2,
+	0x02, 0x8e, 0x43, 0xc2, 0x39, 0xa1, 0x11, 0x37, 0x40, 0x69, 0xb4, 0x3c, 0xbd, 0x61, 0x3e, 0xec,
+	0x63, 0x97, 0x44, 0xc2, 0xbe, 0xb5, 0x55, 0xb3, 0x67, 0x97, 0xc5, 0xcc, 0xf7, 0x9b, 0x93, 0x15,
+	0xad, 0x3d, 0x1b, 0x0e, 0x49, 0x7c, 0xf3, 0xbf, 0x3f, 0xdf, 0x8a, 0xda, 0xe7, 0x9b, 0x93, 0x95,
+	0xbc, 0x8a, 0xe7, 0xa7, 0x34, 0xa6, 0x2a, 0x3e, 0xcb, 0x5f, 0x35, 0x90, 0x1b, 0xbe, 0x0d, 0x2e,
+	0x82, 0x29, 0xd6, 0xf5, 0x9d, 0x7b, 0x49, 0x9a, 0x64, 0x5d, 0xdf, 0x4e, 0x12, 0x94, 0x07, 0xe3,
+	0xb2, 0x89, 0x34, 0x3a, 0x6a, 0x03, 0xdf, 0x00, 0xc0, 0x8f, 0x19, 0x0b, 0xfa, 0x8e, 0x8b, 0x98,
+	0xca, 0x4b, 0x75, 0x35, 0x29, 0xe9, 0xd7, 0x65, 0x71, 0x41, 0x0d, 0x8b, 0x7b, 0xdd, 0x0a, 0xa1,
+	0x56, 0x88, 0x44, 0xa7, 0x52, 0x8f, 0xc4, 0xc5, 0xe9, 0x1a, 0x48, 0xa7, 0x58, 0x8f, 0x44, 0x3b,
+	0xab, 0x8e, 0x6f, 0x23, 0xb6, 0x39, 0x96, 0x94, 0xbb, 0x42, 0x81, 0xfe, 0xf0, 0xa9, 0x60, 0x01,
+	0xfc, 0x5b, 0xdb, 0xb1, 0x1b, 0xad, 0x83, 0xdd, 0x9d, 0xe6, 0xbe, 0x63, 0xb7, 0x1a, 0xf5, 0xed,
+	0x03, 0xa7, 0x65, 0xef, 0x34, 0xf5, 0x0c, 0x2c, 0x82, 0xa5, 0xc7, 0xda, 0x56, 0xa3, 0xd1, 0x7a,
+	0xdf, 0xa8, 0xef, 0xed, 0xeb, 0x1a, 0x34, 0x41, 0xe1, 0xb1, 0xe1, 0x75, 0xeb, 0x9d, 0xd3, 0x6a,
+	0x36, 0x0e, 0xf4, 0x91, 0xea, 0xcb, 0xb3, 0x2b, 0x53, 0x3b, 0xbf, 0x32, 0xb5, 0xdf, 0x57, 0xa6,
+	0xf6, 0xe5, 0xda, 0xcc, 0x9c, 0x5f, 0x9b, 0x99, 0x9f, 0xd7, 0x66, 0xe6, 0xc3, 0x53, 0x9f, 0x88,
+	0xce, 0xf1, 0x61, 0xc5, 0xa5, 0xa1, 0x45, 0xfc, 0x88, 0x08, 0x6c, 0x0d, 0x0f, 0x52, 0xf4, 0x19,
+	0xe6, 0x87, 0x13, 0xf2, 0xc3, 0x7c, 0xf1, 0x77, 0x00, 0xc5, 0xc9, 0xa9, 0x86, 0x0b, 0x04, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -166,6 +333,71 @@ func (this *Params) Equal(that interface{}) bool {
 	if !bytes.Equal(this.StorageFeeCollector, that1.StorageFeeCollector) {
 		return false
 	}
+	if len(this.ReservedNamespaces) != len(that1.ReservedNamespaces) {
+		return false
+	}
+	for i := range this.ReservedNamespaces {
+		if this.ReservedNamespaces[i] != that1.ReservedNamespaces[i] {
+			return false
+		}
+	}
+	if this.DeploymentPolicy != that1.DeploymentPolicy {
+		return false
+	}
+	if len(this.DeploymentAllowlist) != len(that1.DeploymentAllowlist) {
+		return false
+	}
+	for i := range this.DeploymentAllowlist {
+		if this.DeploymentAllowlist[i] != that1.DeploymentAllowlist[i] {
+			return false
+		}
+	}
+	if len(this.RestrictedDenoms) != len(that1.RestrictedDenoms) {
+		return false
+	}
+	for i := range this.RestrictedDenoms {
+		if this.RestrictedDenoms[i] != that1.RestrictedDenoms[i] {
+			return false
+		}
+	}
+	if len(this.MintPermissions) != len(that1.MintPermissions) {
+		return false
+	}
+	for i := range this.MintPermissions {
+		if !this.MintPermissions[i].Equal(&that1.MintPermissions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MintPermission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintPermission)
+	if !ok {
+		that2, ok := that.(MintPermission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PkgPath != that1.PkgPath {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.SupplyCap.Equal(that1.SupplyCap) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -188,6 +420,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintPermissions) > 0 {
+		for iNdEx := len(m.MintPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RestrictedDenoms) > 0 {
+		for iNdEx := len(m.RestrictedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RestrictedDenoms[iNdEx])
+			copy(dAtA[i:], m.RestrictedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.RestrictedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DeploymentAllowlist) > 0 {
+		for iNdEx := len(m.DeploymentAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeploymentAllowlist[iNdEx])
+			copy(dAtA[i:], m.DeploymentAllowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DeploymentAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DeploymentPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeploymentPolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ReservedNamespaces) > 0 {
+		for iNdEx := len(m.ReservedNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReservedNamespaces[iNdEx])
+			copy(dAtA[i:], m.ReservedNamespaces[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ReservedNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.StorageFeeCollector) > 0 {
 		i -= len(m.StorageFeeCollector)
 		copy(dAtA[i:], m.StorageFeeCollector)
@@ -226,6 +504,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SupplyCap.Size()
+		i -= size
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PkgPath) > 0 {
+		i -= len(m.PkgPath)
+		copy(dAtA[i:], m.PkgPath)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PkgPath)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -263,6 +588,52 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.ReservedNamespaces) > 0 {
+		for _, s := range m.ReservedNamespaces {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.DeploymentPolicy != 0 {
+		n += 1 + sovParams(uint64(m.DeploymentPolicy))
+	}
+	if len(m.DeploymentAllowlist) > 0 {
+		for _, s := range m.DeploymentAllowlist {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RestrictedDenoms) > 0 {
+		for _, s := range m.RestrictedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MintPermissions) > 0 {
+		for _, e := range m.MintPermissions {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PkgPath)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -463,6 +834,303 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				m.StorageFeeCollector = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedNamespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedNamespaces = append(m.ReservedNamespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentPolicy", wireType)
+			}
+			m.DeploymentPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeploymentPolicy |= DeploymentPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeploymentAllowlist = append(m.DeploymentAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestrictedDenoms = append(m.RestrictedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintPermissions = append(m.MintPermissions, MintPermission{})
+			if err := m.MintPermissions[len(m.MintPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkgPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkgPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	const addr = "cosmos1qhq6hqzqv3u2nks4mgz9pmwhckw6hznkzwxlec"
+
+	tests := []struct {
+		desc   string
+		update func(p *types.Params)
+		err    string
+	}{
+		{
+			desc:   "default",
+			update: func(p *types.Params) {},
+		},
+		{
+			desc: "allowlist",
+			update: func(p *types.Params) {
+				p.DeploymentPolicy = types.DeploymentPolicy_DEPLOYMENT_POLICY_ALLOWLIST
+				p.DeploymentAllowlist = []string{addr}
+			},
+		},
+		{
+			desc: "invalid deployment policy",
+			update: func(p *types.Params) {
+				p.DeploymentPolicy = 3
+			},
+			err: "invalid deployment policy 3",
+		},
+		{
+			desc: "allowlist without allowlist policy",
+			update: func(p *types.Params) {
+				p.DeploymentPolicy = types.DeploymentPolicy_DEPLOYMENT_POLICY_GOV_ONLY
+				p.DeploymentAllowlist = []string{addr}
+			},
+			err: "deployment allowlist is only used by the DEPLOYMENT_POLICY_ALLOWLIST deployment policy",
+		},
+		{
+			desc: "invalid allowlist address",
+			update: func(p *types.Params) {
+				p.DeploymentPolicy = types.DeploymentPolicy_DEPLOYMENT_POLICY_ALLOWLIST
+				p.DeploymentAllowlist = []string{"alice"}
+			},
+			err: "invalid deployment allowlist address alice",
+		},
+		{
+			desc: "duplicate allowlist address",
+			update: func(p *types.Params) {
+				p.DeploymentPolicy = types.DeploymentPolicy_DEPLOYMENT_POLICY_ALLOWLIST
+				p.DeploymentAllowlist = []string{addr, addr}
+			},
+			err: "duplicate deployment allowlist address " + addr,
+		},
+		{
+			desc: "restricted denoms",
+			update: func(p *types.Params) {
+				p.RestrictedDenoms = []string{"stake", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}
+			},
+		},
+		{
+			desc: "invalid restricted denom",
+			update: func(p *types.Params) {
+				p.RestrictedDenoms = []string{"1stake"}
+			},
+			err: "invalid restricted denom",
+		},
+		{
+			desc: "duplicate restricted denom",
+			update: func(p *types.Params) {
+				p.RestrictedDenoms = []string{"stake", "stake"}
+			},
+			err: "duplicate restricted denom stake",
+		},
+		{
+			desc: "mint permission",
+			update: func(p *types.Params) {
+				p.MintPermissions = []types.MintPermission{
+					{PkgPath: "gno.land/r/demo/token", Denom: "gno/gno.land/r/demo/token/tok", SupplyCap: math.NewInt(1000)},
+					{PkgPath: "gno.land/r/demo/token", Denom: "gno/gno.land/r/demo/token/uncapped"},
+				}
+			},
+		},
+		{
+			desc: "mint permission of a package",
+			update: func(p *types.Params) {
+				p.MintPermissions = []types.MintPermission{{PkgPath: "gno.land/p/demo/token", Denom: "gno/gno.land/p/demo/token/tok"}}
+			},
+			err: `invalid mint permission realm "gno.land/p/demo/token"`,
+		},
+		{
+			desc: "mint permission of another denom",
+			update: func(p *types.Params) {
+				p.MintPermissions = []types.MintPermission{{PkgPath: "gno.land/r/demo/token", Denom: "stake"}}
+			},
+			err: "denom stake cannot be issued by gno.land/r/demo/token",
+		},
+		{
+			desc: "mint permission of another realm",
+			update: func(p *types.Params) {
+				p.MintPermissions = []types.MintPermission{{PkgPath: "gno.land/r/demo/token", Denom: "gno/gno.land/r/demo/other/tok"}}
+			},
+			err: "denom gno/gno.land/r/demo/other/tok cannot be issued by gno.land/r/demo/token",
+		},
+		{
+			desc: "negative supply cap",
+			update: func(p *types.Params) {
+				p.MintPermissions = []types.MintPermission{{PkgPath: "gno.land/r/demo/token", Denom: "gno/gno.land/r/demo/token/tok", SupplyCap: math.NewInt(-1)}}
+			},
+			err: "supply cap of gno/gno.land/r/demo/token/tok cannot be negative",
+		},
+		{
+			desc: "duplicate mint permission",
+			update: func(p *types.Params) {
+				perm := types.MintPermission{PkgPath: "gno.land/r/demo/token", Denom: "gno/gno.land/r/demo/token/tok"}
+				p.MintPermissions = []types.MintPermission{perm, perm}
+			},
+			err: "duplicate mint permission denom gno/gno.land/r/demo/token/tok",
+		},
+		{
+			desc: "invalid reserved namespace",
+			update: func(p *types.Params) {
+				p.ReservedNamespaces = []string{"Sys"}
+			},
+			err: "invalid reserved namespace",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.update(&params)
+
+			err := params.Validate()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
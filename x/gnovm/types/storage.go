@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gno "github.com/gnolang/gno/gnovm/pkg/gnolang"
+)
+
+// Validate validates the storage deposit of a payer.
+func (d StorageDeposit) Validate() error {
+	if !gno.IsRealmPath(d.PkgPath) {
+		return fmt.Errorf("invalid storage deposit realm %q", d.PkgPath)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Payer); err != nil {
+		return fmt.Errorf("invalid payer of storage deposit of %s: %w", d.PkgPath, err)
+	}
+	if err := d.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid storage deposit of %s: %w", d.PkgPath, err)
+	}
+	if d.Amount.IsZero() {
+		return fmt.Errorf("storage deposit of %s cannot be empty", d.PkgPath)
+	}
+
+	return nil
+}
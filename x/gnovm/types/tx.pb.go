@@ -131,6 +131,9 @@ type MsgAddPackage struct {
 	Send       []types.Coin `protobuf:"bytes,2,rep,name=send,proto3" json:"send"`
 	MaxDeposit []types.Coin `protobuf:"bytes,3,rep,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit"`
 	Package    []byte       `protobuf:"bytes,4,opt,name=package,proto3" json:"package,omitempty"`
+	// admin is an optional address allowed to upgrade the package, along with
+	// the creator and the module authority.
+	Admin string `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
 func (m *MsgAddPackage) Reset()         { *m = MsgAddPackage{} }
@@ -194,6 +197,13 @@ func (m *MsgAddPackage) GetPackage() []byte {
 	return nil
 }
 
+func (m *MsgAddPackage) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
 // MsgAddPackageResponse defines the MsgAddPackageResponse message.
 type MsgAddPackageResponse struct {
 }
@@ -231,6 +241,127 @@ func (m *MsgAddPackageResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddPackageResponse proto.InternalMessageInfo
 
+// MsgAddPackages defines the MsgAddPackages message, which adds several
+// packages at once. The packages are deployed in the order of their imports,
+// and either all of them or none are added.
+type MsgAddPackages struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// max_deposit is the maximum storage deposit of all the packages.
+	MaxDeposit []types.Coin `protobuf:"bytes,2,rep,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit"`
+	// packages holds the JSON encoded packages, in any order.
+	Packages [][]byte `protobuf:"bytes,3,rep,name=packages,proto3" json:"packages,omitempty"`
+	// admin is an optional address allowed to upgrade the packages, along with
+	// the creator and the module authority.
+	Admin string `protobuf:"bytes,4,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *MsgAddPackages) Reset()         { *m = MsgAddPackages{} }
+func (m *MsgAddPackages) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPackages) ProtoMessage()    {}
+func (*MsgAddPackages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11744954a7c1251, []int{4}
+}
+func (m *MsgAddPackages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPackages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPackages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddPackages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPackages.Merge(m, src)
+}
+func (m *MsgAddPackages) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPackages) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPackages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddPackages proto.InternalMessageInfo
+
+func (m *MsgAddPackages) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAddPackages) GetMaxDeposit() []types.Coin {
+	if m != nil {
+		return m.MaxDeposit
+	}
+	return nil
+}
+
+func (m *MsgAddPackages) GetPackages() [][]byte {
+	if m != nil {
+		return m.Packages
+	}
+	return nil
+}
+
+func (m *MsgAddPackages) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// MsgAddPackagesResponse defines the MsgAddPackagesResponse message.
+type MsgAddPackagesResponse struct {
+	// pkg_paths holds the paths of the packages, in deployment order.
+	PkgPaths []string `protobuf:"bytes,1,rep,name=pkg_paths,json=pkgPaths,proto3" json:"pkg_paths,omitempty"`
+}
+
+func (m *MsgAddPackagesResponse) Reset()         { *m = MsgAddPackagesResponse{} }
+func (m *MsgAddPackagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPackagesResponse) ProtoMessage()    {}
+func (*MsgAddPackagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11744954a7c1251, []int{5}
+}
+func (m *MsgAddPackagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPackagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPackagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddPackagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPackagesResponse.Merge(m, src)
+}
+func (m *MsgAddPackagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPackagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPackagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddPackagesResponse proto.InternalMessageInfo
+
+func (m *MsgAddPackagesResponse) GetPkgPaths() []string {
+	if m != nil {
+		return m.PkgPaths
+	}
+	return nil
+}
+
 // MsgCall defines the MsgCall message.
 type MsgCall struct {
 	Caller     string       `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
@@ -239,13 +370,18 @@ type MsgCall struct {
 	PkgPath    string       `protobuf:"bytes,4,opt,name=pkg_path,json=pkgPath,proto3" json:"pkg_path,omitempty"`
 	Function   string       `protobuf:"bytes,5,opt,name=function,proto3" json:"function,omitempty"`
 	Args       []string     `protobuf:"bytes,6,rep,name=args,proto3" json:"args,omitempty"`
+	// json_args holds the arguments as a JSON array, decoded against the
+	// parameters of the function, which unlike args can be of composite types
+	// (structs, slices, maps, coins, ...). It cannot be used along with args.
+	// The function is called as with args, from the caller and with send.
+	JsonArgs string `protobuf:"bytes,7,opt,name=json_args,json=jsonArgs,proto3" json:"json_args,omitempty"`
 }
 
 func (m *MsgCall) Reset()         { *m = MsgCall{} }
 func (m *MsgCall) String() string { return proto.CompactTextString(m) }
 func (*MsgCall) ProtoMessage()    {}
 func (*MsgCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c11744954a7c1251, []int{4}
+	return fileDescriptor_c11744954a7c1251, []int{6}
 }
 func (m *MsgCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,16 +452,26 @@ func (m *MsgCall) GetArgs() []string {
 	return nil
 }
 
+func (m *MsgCall) GetJsonArgs() string {
+	if m != nil {
+		return m.JsonArgs
+	}
+	return ""
+}
+
 // MsgCallResponse defines the MsgCallResponse message.
 type MsgCallResponse struct {
+	// result holds the returned values in the VM's debug print format.
 	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// results holds the returned values, decoded from result.
+	Results []TypedValue `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
 }
 
 func (m *MsgCallResponse) Reset()         { *m = MsgCallResponse{} }
 func (m *MsgCallResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCallResponse) ProtoMessage()    {}
 func (*MsgCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c11744954a7c1251, []int{5}
+	return fileDescriptor_c11744954a7c1251, []int{7}
 }
 func (m *MsgCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,6 +507,13 @@ func (m *MsgCallResponse) GetResult() string {
 	return ""
 }
 
+func (m *MsgCallResponse) GetResults() []TypedValue {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // MsgRun defines the MsgRun message.
 type MsgRun struct {
 	Caller     string       `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
@@ -373,7 +526,7 @@ func (m *MsgRun) Reset()         { *m = MsgRun{} }
 func (m *MsgRun) String() string { return proto.CompactTextString(m) }
 func (*MsgRun) ProtoMessage()    {}
 func (*MsgRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c11744954a7c1251, []int{6}
+	return fileDescriptor_c11744954a7c1251, []int{8}
 }
 func (m *MsgRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,14 +585,17 @@ func (m *MsgRun) GetPkg() []byte {
 
 // MsgRunResponse defines the MsgRunResponse message.
 type MsgRunResponse struct {
+	// result holds the output of the script.
 	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// results holds the output of the script, as a single string value.
+	Results []TypedValue `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
 }
 
 func (m *MsgRunResponse) Reset()         { *m = MsgRunResponse{} }
 func (m *MsgRunResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRunResponse) ProtoMessage()    {}
 func (*MsgRunResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c11744954a7c1251, []int{7}
+	return fileDescriptor_c11744954a7c1251, []int{9}
 }
 func (m *MsgRunResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,794 +631,3070 @@ func (m *MsgRunResponse) GetResult() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "gnovm.gnovm.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "gnovm.gnovm.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgAddPackage)(nil), "gnovm.gnovm.v1.MsgAddPackage")
-	proto.RegisterType((*MsgAddPackageResponse)(nil), "gnovm.gnovm.v1.MsgAddPackageResponse")
-	proto.RegisterType((*MsgCall)(nil), "gnovm.gnovm.v1.MsgCall")
-	proto.RegisterType((*MsgCallResponse)(nil), "gnovm.gnovm.v1.MsgCallResponse")
-	proto.RegisterType((*MsgRun)(nil), "gnovm.gnovm.v1.MsgRun")
-	proto.RegisterType((*MsgRunResponse)(nil), "gnovm.gnovm.v1.MsgRunResponse")
+func (m *MsgRunResponse) GetResults() []TypedValue {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("gnovm/gnovm/v1/tx.proto", fileDescriptor_c11744954a7c1251) }
-
-var fileDescriptor_c11744954a7c1251 = []byte{
-	// 653 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0xb3, 0x4d, 0x9a, 0x36, 0x2f, 0xb5, 0xea, 0x52, 0x9b, 0xcd, 0x4a, 0xb7, 0x21, 0x50,
-	0x8c, 0x05, 0x77, 0x4d, 0x0b, 0x82, 0x05, 0xb1, 0x4d, 0xbd, 0x06, 0xca, 0x8a, 0x20, 0x5e, 0xca,
-	0x64, 0x77, 0x9c, 0x2c, 0xc9, 0xee, 0x2c, 0x3b, 0x93, 0x90, 0xde, 0xc4, 0xa3, 0x27, 0xff, 0x0c,
-	0x8f, 0x3d, 0x88, 0x17, 0xff, 0x81, 0x1e, 0x8b, 0x27, 0x4f, 0x22, 0xed, 0xa1, 0x57, 0xff, 0x04,
-	0x99, 0x9d, 0xd9, 0xc4, 0x84, 0x68, 0xbd, 0xe9, 0x65, 0x98, 0x37, 0xdf, 0xf7, 0xde, 0xbc, 0xcf,
-	0x9b, 0x1f, 0x50, 0x21, 0x11, 0x1d, 0x86, 0x8e, 0x1c, 0x87, 0x4d, 0x87, 0x8f, 0xec, 0x38, 0xa1,
-	0x9c, 0xea, 0xab, 0xe9, 0x92, 0x2d, 0xc7, 0x61, 0xd3, 0xbc, 0x8d, 0xc2, 0x20, 0xa2, 0x4e, 0x3a,
-	0x4a, 0x17, 0xd3, 0xf2, 0x28, 0x0b, 0x29, 0x73, 0x3a, 0x88, 0x61, 0x67, 0xd8, 0xec, 0x60, 0x8e,
-	0x9a, 0x8e, 0x47, 0x83, 0x48, 0xe9, 0x15, 0xa5, 0x87, 0x8c, 0x88, 0xd4, 0x21, 0x23, 0x4a, 0xa8,
-	0x4a, 0xe1, 0x38, 0xb5, 0x1c, 0x69, 0x28, 0xe9, 0xee, 0x4c, 0x3d, 0x31, 0x4a, 0x50, 0x98, 0x89,
-	0x6b, 0x84, 0x12, 0x2a, 0x83, 0xc4, 0x4c, 0xae, 0xd6, 0x3f, 0x69, 0x70, 0xb3, 0xcd, 0xc8, 0x8b,
-	0xd8, 0x47, 0x1c, 0x1f, 0xa5, 0xfe, 0xfa, 0x23, 0x28, 0xa1, 0x01, 0xef, 0xd2, 0x24, 0xe0, 0x27,
-	0x86, 0x56, 0xd3, 0x1a, 0xa5, 0x96, 0xf1, 0xe5, 0xe3, 0x83, 0x35, 0xb5, 0xd7, 0x81, 0xef, 0x27,
-	0x98, 0xb1, 0xe7, 0x3c, 0x09, 0x22, 0xe2, 0x4e, 0x5c, 0xf5, 0xc7, 0x50, 0x94, 0x3b, 0x1a, 0x0b,
-	0x35, 0xad, 0x51, 0xde, 0x59, 0xb7, 0xa7, 0xdb, 0x60, 0xcb, 0xfc, 0xad, 0xd2, 0xd9, 0xb7, 0xcd,
-	0xdc, 0x87, 0xab, 0xd3, 0x6d, 0xcd, 0x55, 0x01, 0x7b, 0x0f, 0xdf, 0x5e, 0x9d, 0x6e, 0x4f, 0x52,
-	0xbd, 0xbb, 0x3a, 0xdd, 0xde, 0x90, 0x18, 0x23, 0x85, 0x33, 0x53, 0x64, 0xbd, 0x0a, 0x95, 0x99,
-	0x25, 0x17, 0xb3, 0x98, 0x46, 0x0c, 0xd7, 0xcf, 0x34, 0xb8, 0xd1, 0x66, 0xe4, 0xc0, 0xf7, 0x8f,
-	0x90, 0xd7, 0x43, 0x04, 0xeb, 0x06, 0x2c, 0x79, 0x09, 0x46, 0x9c, 0x26, 0x92, 0xc7, 0xcd, 0x4c,
-	0x7d, 0x17, 0x0a, 0x0c, 0x47, 0xbe, 0xb1, 0x50, 0xcb, 0x37, 0xca, 0x3b, 0x55, 0x5b, 0x31, 0x8a,
-	0x53, 0xb1, 0xd5, 0xa9, 0xd8, 0x87, 0x34, 0x88, 0x5a, 0x05, 0x51, 0xb4, 0x9b, 0x3a, 0xeb, 0xfb,
-	0x50, 0x0e, 0xd1, 0xe8, 0xd8, 0xc7, 0x31, 0x65, 0x01, 0x37, 0xf2, 0x7f, 0x17, 0x0b, 0x21, 0x1a,
-	0x3d, 0x93, 0x21, 0xa2, 0xa0, 0x58, 0xd6, 0x66, 0x14, 0x6a, 0x5a, 0x63, 0xc5, 0xcd, 0xcc, 0xbd,
-	0x15, 0xd1, 0x89, 0xac, 0xbc, 0x7a, 0x05, 0xee, 0x4c, 0x91, 0x8c, 0x19, 0x7f, 0x68, 0xb0, 0xd4,
-	0x66, 0xe4, 0x10, 0xf5, 0xfb, 0xfa, 0x3a, 0x14, 0x3d, 0xd4, 0xef, 0xe3, 0x0c, 0x4e, 0x59, 0xff,
-	0x8a, 0xad, 0x0a, 0xcb, 0x71, 0x8f, 0x1c, 0xc7, 0x88, 0x77, 0x53, 0xb8, 0x92, 0xbb, 0x14, 0xf7,
-	0xc8, 0x11, 0xe2, 0x5d, 0xdd, 0x84, 0xe5, 0xd7, 0x83, 0xc8, 0xe3, 0x01, 0x8d, 0x8c, 0xc5, 0x54,
-	0x1a, 0xdb, 0xba, 0x0e, 0x05, 0x94, 0x10, 0x66, 0x14, 0x6b, 0xf9, 0x46, 0xc9, 0x4d, 0xe7, 0x7b,
-	0x65, 0xd1, 0x0c, 0x85, 0x53, 0xbf, 0x9f, 0xde, 0x54, 0x41, 0x9c, 0x75, 0x41, 0x90, 0x27, 0x98,
-	0x0d, 0xfa, 0x3c, 0x23, 0x97, 0x96, 0xb8, 0xd5, 0xc5, 0x36, 0x23, 0xee, 0x20, 0xfa, 0xdf, 0x9a,
-	0x73, 0x0b, 0xf2, 0x71, 0x8f, 0xa8, 0x43, 0x17, 0xd3, 0x69, 0xc6, 0x06, 0xac, 0xca, 0xba, 0xaf,
-	0x43, 0xdc, 0xf9, 0xbc, 0x00, 0xf9, 0x36, 0x23, 0xfa, 0x4b, 0x58, 0x99, 0x7a, 0xbc, 0x9b, 0xb3,
-	0x8f, 0x6e, 0xe6, 0x95, 0x98, 0xf7, 0xae, 0x71, 0x18, 0xef, 0xec, 0x02, 0xfc, 0xf2, 0x84, 0x36,
-	0xe6, 0x84, 0x4d, 0x64, 0x73, 0xeb, 0x8f, 0xf2, 0x38, 0xe7, 0x3e, 0x14, 0xd2, 0x2b, 0x5b, 0x99,
-	0xe3, 0x2e, 0x04, 0x73, 0xf3, 0x37, 0xc2, 0x38, 0xc3, 0x13, 0xc8, 0xa7, 0xc7, 0x3a, 0xc7, 0xcf,
-	0x1d, 0x44, 0xa6, 0x35, 0x7f, 0x3d, 0x0b, 0x37, 0x17, 0xdf, 0x88, 0x7f, 0xa7, 0xf5, 0xf4, 0xec,
-	0xc2, 0xd2, 0xce, 0x2f, 0x2c, 0xed, 0xfb, 0x85, 0xa5, 0xbd, 0xbf, 0xb4, 0x72, 0xe7, 0x97, 0x56,
-	0xee, 0xeb, 0xa5, 0x95, 0x7b, 0xb5, 0x45, 0x02, 0xde, 0x1d, 0x74, 0x6c, 0x8f, 0x86, 0x4e, 0x40,
-	0xa2, 0x80, 0x63, 0x67, 0xfa, 0x23, 0xe2, 0x27, 0x31, 0x66, 0x9d, 0x62, 0xfa, 0x7d, 0xee, 0xfe,
-	0x0c, 0x00, 0x00, 0xff, 0xff, 0x55, 0x2d, 0xbb, 0x02, 0x03, 0x06, 0x00, 0x00,
+// MsgClaimNamespace defines the MsgClaimNamespace message, which claims an
+// unclaimed namespace of package paths for the owner.
+type MsgClaimNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgClaimNamespace) Reset()         { *m = MsgClaimNamespace{} }
+func (m *MsgClaimNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimNamespace) ProtoMessage()    {}
+func (*MsgClaimNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11744954a7c1251, []int{10}
+}
+func (m *MsgClaimNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimNamespace.Merge(m, src)
+}
+func (m *MsgClaimNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimNamespace.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgClaimNamespace proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// AddPackage defines the AddPackage RPC.
-	AddPackage(ctx context.Context, in *MsgAddPackage, opts ...grpc.CallOption) (*MsgAddPackageResponse, error)
-	// Call defines the Call RPC.
-	Call(ctx context.Context, in *MsgCall, opts ...grpc.CallOption) (*MsgCallResponse, error)
-	// Run defines the Run RPC.
-	Run(ctx context.Context, in *MsgRun, opts ...grpc.CallOption) (*MsgRunResponse, error)
+func (m *MsgClaimNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgClaimNamespace) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgClaimNamespaceResponse defines the MsgClaimNamespaceResponse message.
+type MsgClaimNamespaceResponse struct {
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimNamespaceResponse) Reset()         { *m = MsgClaimNamespaceResponse{} }
+func (m *MsgClaimNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimNamespaceResponse) ProtoMessage()    {}
+func (*MsgClaimNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11744954a7c1251, []int{11}
+}
+func (m *MsgClaimNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgClaimNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimNamespaceResponse.Merge(m, src)
+}
+func (m *MsgClaimNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimNamespaceResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddPackage(ctx context.Context, in *MsgAddPackage, opts ...grpc.CallOption) (*MsgAddPackageResponse, error) {
-	out := new(MsgAddPackageResponse)
-	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/AddPackage", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimNamespaceResponse proto.InternalMessageInfo
+
+// MsgTransferNamespace defines the MsgTransferNamespace message, which
+// transfers a namespace to a new owner. The module authority can transfer
+// any namespace.
+type MsgTransferNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NewOwner  string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *MsgTransferNamespace) Reset()         { *m = MsgTransferNamespace{} }
+func (m *MsgTransferNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNamespace) ProtoMessage()    {}
+func (*MsgTransferNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11744954a7c1251, []int{12}
+}
+func (m *MsgTransferNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTransferNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNamespace.Merge(m, src)
+}
+func (m *MsgTransferNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNamespace.DiscardUnknown(m)
 }
 
-func (c *msgClient) Call(ctx context.Context, in *MsgCall, opts ...grpc.CallOption) (*MsgCallResponse, error) {
-	out := new(MsgCallResponse)
-	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/Call", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferNamespace proto.InternalMessageInfo
+
+func (m *MsgTransferNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Run(ctx context.Context, in *MsgRun, opts ...grpc.CallOption) (*MsgRunResponse, error) {
-	out := new(MsgRunResponse)
-	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/Run", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferNamespace) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// AddPackage defines the AddPackage RPC.
-	AddPackage(context.Context, *MsgAddPackage) (*MsgAddPackageResponse, error)
-	// Call defines the Call RPC.
-	Call(context.Context, *MsgCall) (*MsgCallResponse, error)
-	// Run defines the Run RPC.
-	Run(context.Context, *MsgRun) (*MsgRunResponse, error)
+func (m *MsgTransferNamespace) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgTransferNamespaceResponse defines the MsgTransferNamespaceResponse message.
+type MsgTransferNamespaceResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgTransferNamespaceResponse) Reset()         { *m = MsgTransferNamespaceResponse{} }
+func (m *MsgTransferNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNamespaceResponse) ProtoMessage()    {}
+func (*MsgTransferNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11744954a7c1251, []int{13}
 }
-func (*UnimplementedMsgServer) AddPackage(ctx context.Context, req *MsgAddPackage) (*MsgAddPackageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPackage not implemented")
+func (m *MsgTransferNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Call(ctx context.Context, req *MsgCall) (*MsgCallResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+func (m *MsgTransferNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Run(ctx context.Context, req *MsgRun) (*MsgRunResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Run not implemented")
+func (m *MsgTransferNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNamespaceResponse.Merge(m, src)
+}
+func (m *MsgTransferNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNamespaceResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgTransferNamespaceResponse proto.InternalMessageInfo
+
+// MsgReleaseNamespace defines the MsgReleaseNamespace message, which releases
+// a namespace so that it can be claimed again. The packages already added
+// under the namespace are kept. The module authority can release any
+// namespace.
+type MsgReleaseNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnovm.gnovm.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgReleaseNamespace) Reset()         { *m = MsgReleaseNamespace{} }
+func (m *MsgReleaseNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseNamespace) ProtoMessage()    {}
+func (*MsgReleaseNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11744954a7c1251, []int{14}
 }
-
-func _Msg_AddPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddPackage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddPackage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnovm.gnovm.v1.Msg/AddPackage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddPackage(ctx, req.(*MsgAddPackage))
+func (m *MsgReleaseNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgReleaseNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseNamespace.Merge(m, src)
+}
+func (m *MsgReleaseNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseNamespace.DiscardUnknown(m)
 }
 
-func _Msg_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCall)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Call(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnovm.gnovm.v1.Msg/Call",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Call(ctx, req.(*MsgCall))
+var xxx_messageInfo_MsgReleaseNamespace proto.InternalMessageInfo
+
+func (m *MsgReleaseNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Run_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRun)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Run(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnovm.gnovm.v1.Msg/Run",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Run(ctx, req.(*MsgRun))
+func (m *MsgReleaseNamespace) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gnovm.gnovm.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "AddPackage",
-			Handler:    _Msg_AddPackage_Handler,
-		},
-		{
-			MethodName: "Call",
-			Handler:    _Msg_Call_Handler,
-		},
-		{
-			MethodName: "Run",
-			Handler:    _Msg_Run_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "gnovm/gnovm/v1/tx.proto",
+// MsgReleaseNamespaceResponse defines the MsgReleaseNamespaceResponse message.
+type MsgReleaseNamespaceResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReleaseNamespaceResponse) Reset()         { *m = MsgReleaseNamespaceResponse{} }
+func (m *MsgReleaseNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseNamespaceResponse) ProtoMessage()    {}
+func (*MsgReleaseNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11744954a7c1251, []int{15}
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseNamespaceResponse.Merge(m, src)
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseNamespaceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReleaseNamespaceResponse proto.InternalMessageInfo
+
+// MsgUpgradePackage defines the MsgUpgradePackage message, which replaces the
+// code of a deployed package, keeping the state of the realm. Only the creator
+// of the package, its admin or the module authority can upgrade it, and the
+// upgrade must keep the types of the package variables and declared types.
+type MsgUpgradePackage struct {
+	Sender     string       `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MaxDeposit []types.Coin `protobuf:"bytes,2,rep,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit"`
+	// package holds the JSON encoded package, with the path of the deployed
+	// package.
+	Package []byte `protobuf:"bytes,3,opt,name=package,proto3" json:"package,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpgradePackage) Reset()         { *m = MsgUpgradePackage{} }
+func (m *MsgUpgradePackage) String() string { return proto.CompactTextString(m) }
+func (*MsgUpgradePackage) ProtoMessage()    {}
+func (*MsgUpgradePackage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11744954a7c1251, []int{16}
+}
+func (m *MsgUpgradePackage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpgradePackage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpgradePackage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpgradePackage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpgradePackage.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpgradePackage) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpgradePackage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpgradePackage.DiscardUnknown(m)
 }
 
-func (m *MsgAddPackage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpgradePackage proto.InternalMessageInfo
+
+func (m *MsgUpgradePackage) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddPackage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpgradePackage) GetMaxDeposit() []types.Coin {
+	if m != nil {
+		return m.MaxDeposit
+	}
+	return nil
 }
 
-func (m *MsgAddPackage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Package) > 0 {
-		i -= len(m.Package)
-		copy(dAtA[i:], m.Package)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Package)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MaxDeposit) > 0 {
-		for iNdEx := len(m.MaxDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MaxDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Send) > 0 {
-		for iNdEx := len(m.Send) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Send[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpgradePackage) GetPackage() []byte {
+	if m != nil {
+		return m.Package
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgAddPackageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgUpgradePackageResponse defines the MsgUpgradePackageResponse message.
+type MsgUpgradePackageResponse struct {
 }
 
-func (m *MsgAddPackageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpgradePackageResponse) Reset()         { *m = MsgUpgradePackageResponse{} }
+func (m *MsgUpgradePackageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpgradePackageResponse) ProtoMessage()    {}
+func (*MsgUpgradePackageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11744954a7c1251, []int{17}
+}
+func (m *MsgUpgradePackageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpgradePackageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpgradePackageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpgradePackageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpgradePackageResponse.Merge(m, src)
+}
+func (m *MsgUpgradePackageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpgradePackageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpgradePackageResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddPackageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpgradePackageResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "gnovm.gnovm.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "gnovm.gnovm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddPackage)(nil), "gnovm.gnovm.v1.MsgAddPackage")
+	proto.RegisterType((*MsgAddPackageResponse)(nil), "gnovm.gnovm.v1.MsgAddPackageResponse")
+	proto.RegisterType((*MsgAddPackages)(nil), "gnovm.gnovm.v1.MsgAddPackages")
+	proto.RegisterType((*MsgAddPackagesResponse)(nil), "gnovm.gnovm.v1.MsgAddPackagesResponse")
+	proto.RegisterType((*MsgCall)(nil), "gnovm.gnovm.v1.MsgCall")
+	proto.RegisterType((*MsgCallResponse)(nil), "gnovm.gnovm.v1.MsgCallResponse")
+	proto.RegisterType((*MsgRun)(nil), "gnovm.gnovm.v1.MsgRun")
+	proto.RegisterType((*MsgRunResponse)(nil), "gnovm.gnovm.v1.MsgRunResponse")
+	proto.RegisterType((*MsgClaimNamespace)(nil), "gnovm.gnovm.v1.MsgClaimNamespace")
+	proto.RegisterType((*MsgClaimNamespaceResponse)(nil), "gnovm.gnovm.v1.MsgClaimNamespaceResponse")
+	proto.RegisterType((*MsgTransferNamespace)(nil), "gnovm.gnovm.v1.MsgTransferNamespace")
+	proto.RegisterType((*MsgTransferNamespaceResponse)(nil), "gnovm.gnovm.v1.MsgTransferNamespaceResponse")
+	proto.RegisterType((*MsgReleaseNamespace)(nil), "gnovm.gnovm.v1.MsgReleaseNamespace")
+	proto.RegisterType((*MsgReleaseNamespaceResponse)(nil), "gnovm.gnovm.v1.MsgReleaseNamespaceResponse")
+	proto.RegisterType((*MsgUpgradePackage)(nil), "gnovm.gnovm.v1.MsgUpgradePackage")
+	proto.RegisterType((*MsgUpgradePackageResponse)(nil), "gnovm.gnovm.v1.MsgUpgradePackageResponse")
 }
 
-func (m *MsgCall) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("gnovm/gnovm/v1/tx.proto", fileDescriptor_c11744954a7c1251) }
+
+var fileDescriptor_c11744954a7c1251 = []byte{
+	// 1001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x9b, 0x34, 0x6d, 0x5e, 0x4b, 0x77, 0x6b, 0x4a, 0xeb, 0xb8, 0x5b, 0x37, 0x1b, 0x58,
+	0xc8, 0x16, 0x48, 0xb6, 0x5d, 0x2d, 0x12, 0x91, 0x10, 0xdb, 0x2e, 0xd7, 0x40, 0x65, 0xb6, 0x08,
+	0x71, 0x20, 0x9a, 0xc6, 0xb3, 0x53, 0xd3, 0x78, 0xc6, 0xf2, 0x38, 0x69, 0x7b, 0x43, 0x1c, 0x91,
+	0x90, 0xf8, 0x0b, 0x9c, 0xe0, 0x84, 0x7a, 0x40, 0xfc, 0x04, 0xb4, 0xc7, 0x15, 0x27, 0x4e, 0x08,
+	0xb5, 0x87, 0xfe, 0x05, 0x8e, 0x68, 0x3c, 0x63, 0x27, 0x76, 0x4d, 0x52, 0x10, 0x0b, 0x5c, 0x2c,
+	0xbf, 0x79, 0xdf, 0x7b, 0xf3, 0xbe, 0x6f, 0x66, 0xde, 0x0c, 0xac, 0x12, 0xca, 0x06, 0x5e, 0x53,
+	0x7e, 0x07, 0x5b, 0xcd, 0xf0, 0xa4, 0xe1, 0x07, 0x2c, 0x64, 0xfa, 0x62, 0x34, 0xd4, 0x90, 0xdf,
+	0xc1, 0x96, 0xb9, 0x84, 0x3c, 0x97, 0xb2, 0x66, 0xf4, 0x95, 0x10, 0xd3, 0xea, 0x32, 0xee, 0x31,
+	0xde, 0x3c, 0x40, 0x1c, 0x37, 0x07, 0x5b, 0x07, 0x38, 0x44, 0x5b, 0xcd, 0x2e, 0x73, 0xa9, 0xf2,
+	0xaf, 0x2a, 0xbf, 0xc7, 0x89, 0x48, 0xed, 0x71, 0xa2, 0x1c, 0x15, 0xe9, 0xe8, 0x44, 0x56, 0x53,
+	0x1a, 0xca, 0xb5, 0x96, 0xa9, 0xc7, 0x47, 0x01, 0xf2, 0x62, 0xa7, 0x99, 0x71, 0x0e, 0x50, 0xaf,
+	0x8f, 0x95, 0x6f, 0x99, 0x30, 0xc2, 0x64, 0x42, 0xf1, 0x27, 0x47, 0x6b, 0x3f, 0x6a, 0x70, 0xa3,
+	0xcd, 0xc9, 0xbe, 0xef, 0xa0, 0x10, 0xef, 0x45, 0xb9, 0xf4, 0xb7, 0xa0, 0x8c, 0xfa, 0xe1, 0x21,
+	0x0b, 0xdc, 0xf0, 0xd4, 0xd0, 0xaa, 0x5a, 0xbd, 0xbc, 0x6b, 0xfc, 0xfc, 0xc3, 0x9b, 0xcb, 0xaa,
+	0x8e, 0x1d, 0xc7, 0x09, 0x30, 0xe7, 0x1f, 0x86, 0x81, 0x4b, 0x89, 0x3d, 0x84, 0xea, 0x6f, 0x43,
+	0x49, 0x56, 0x63, 0x4c, 0x57, 0xb5, 0xfa, 0xfc, 0xf6, 0x4a, 0x23, 0x2d, 0x51, 0x43, 0xe6, 0xdf,
+	0x2d, 0x3f, 0xfd, 0x75, 0x63, 0xea, 0xbb, 0xcb, 0xb3, 0x4d, 0xcd, 0x56, 0x01, 0xad, 0x7b, 0x5f,
+	0x5c, 0x9e, 0x6d, 0x0e, 0x53, 0x7d, 0x79, 0x79, 0xb6, 0xb9, 0x2e, 0x59, 0x9c, 0x28, 0x36, 0x99,
+	0x22, 0x6b, 0x15, 0x58, 0xcd, 0x0c, 0xd9, 0x98, 0xfb, 0x8c, 0x72, 0x5c, 0xfb, 0x5d, 0x83, 0x17,
+	0xda, 0x9c, 0xec, 0x38, 0xce, 0x1e, 0xea, 0x1e, 0x21, 0x82, 0x75, 0x03, 0x66, 0xbb, 0x01, 0x46,
+	0x21, 0x0b, 0x24, 0x1f, 0x3b, 0x36, 0xf5, 0xfb, 0x50, 0xe4, 0x98, 0x3a, 0xc6, 0x74, 0xb5, 0x50,
+	0x9f, 0xdf, 0xae, 0x34, 0x14, 0x47, 0xb1, 0x62, 0x0d, 0xb5, 0x62, 0x8d, 0x47, 0xcc, 0xa5, 0xbb,
+	0x45, 0x51, 0xb4, 0x1d, 0x81, 0xf5, 0x87, 0x30, 0xef, 0xa1, 0x93, 0x8e, 0x83, 0x7d, 0xc6, 0xdd,
+	0xd0, 0x28, 0x5c, 0x2f, 0x16, 0x3c, 0x74, 0xf2, 0x9e, 0x0c, 0x11, 0x05, 0xf9, 0xb2, 0x36, 0xa3,
+	0x58, 0xd5, 0xea, 0x0b, 0x76, 0x6c, 0xea, 0x0d, 0x98, 0x41, 0x8e, 0xe7, 0x52, 0x63, 0x66, 0x82,
+	0xf0, 0x12, 0xd6, 0x5a, 0x10, 0xca, 0xc5, 0x74, 0x6a, 0xab, 0xf0, 0x52, 0x8a, 0x79, 0xa2, 0xc9,
+	0x4f, 0x1a, 0x2c, 0xa6, 0x3c, 0x7c, 0x8c, 0x28, 0x19, 0x7e, 0xd3, 0x7f, 0x9d, 0x9f, 0x09, 0x73,
+	0x8a, 0x10, 0x8f, 0xe4, 0x59, 0xb0, 0x13, 0x7b, 0xc8, 0xb0, 0xf8, 0x77, 0x18, 0x3e, 0x80, 0x95,
+	0x34, 0x8f, 0x98, 0xa2, 0xbe, 0x06, 0x65, 0xff, 0x88, 0x74, 0x7c, 0x14, 0x1e, 0x72, 0x43, 0xab,
+	0x16, 0xea, 0x65, 0x7b, 0xce, 0x3f, 0x22, 0x7b, 0xc2, 0xae, 0x7d, 0x35, 0x0d, 0xb3, 0x6d, 0x4e,
+	0x1e, 0xa1, 0x5e, 0x4f, 0x5f, 0x81, 0x52, 0x17, 0xf5, 0x7a, 0x38, 0xe6, 0xad, 0xac, 0xff, 0x6a,
+	0x2f, 0x54, 0x60, 0x2e, 0xae, 0x5b, 0x4a, 0x62, 0xcf, 0xaa, 0xb2, 0x85, 0x8c, 0x4f, 0xfa, 0xb4,
+	0x1b, 0xba, 0x4c, 0xed, 0x07, 0x3b, 0xb1, 0x75, 0x1d, 0x8a, 0x28, 0x20, 0xdc, 0x28, 0x45, 0x4c,
+	0xa3, 0x7f, 0x21, 0xc1, 0x67, 0x9c, 0xd1, 0x4e, 0xe4, 0x98, 0x95, 0x01, 0x62, 0x60, 0x27, 0x20,
+	0xbc, 0x35, 0x2f, 0x74, 0x54, 0x5c, 0x6b, 0x18, 0x6e, 0x28, 0x39, 0x12, 0xfd, 0x56, 0xa0, 0x14,
+	0x60, 0xde, 0xef, 0x85, 0xb1, 0x2c, 0xd2, 0xd2, 0x5b, 0x30, 0x2b, 0xff, 0xb8, 0x52, 0xc6, 0xcc,
+	0x9e, 0xeb, 0xc7, 0xa7, 0x3e, 0x76, 0x3e, 0x12, 0xbd, 0x46, 0xd1, 0x8b, 0x03, 0x44, 0x7b, 0x29,
+	0xb5, 0x39, 0xb1, 0xfb, 0xf4, 0xff, 0xa6, 0xfa, 0x4d, 0x28, 0xf8, 0x47, 0x44, 0x9d, 0x3e, 0xf1,
+	0x9b, 0xd6, 0xc7, 0x89, 0x8e, 0x8b, 0xdd, 0xa7, 0xcf, 0x55, 0x1e, 0x0f, 0x96, 0xc4, 0x2a, 0xf4,
+	0x90, 0xeb, 0xbd, 0x8f, 0x3c, 0xcc, 0x7d, 0xd4, 0x8d, 0x3a, 0x00, 0x3b, 0xa6, 0xb1, 0x4e, 0xe3,
+	0xce, 0x47, 0x04, 0xd3, 0x6f, 0x41, 0x99, 0xc6, 0xc1, 0x51, 0xe7, 0x2d, 0xdb, 0xc3, 0x81, 0x16,
+	0x08, 0x56, 0x12, 0x59, 0x5b, 0x83, 0xca, 0x95, 0xe9, 0x92, 0x0e, 0xf1, 0xad, 0x06, 0xcb, 0x6d,
+	0x4e, 0x1e, 0x07, 0x88, 0xf2, 0x27, 0x38, 0x78, 0x4e, 0xf5, 0xe8, 0x0f, 0xa0, 0x4c, 0xf1, 0x71,
+	0x47, 0x66, 0x2c, 0x4c, 0xc8, 0x38, 0x47, 0xf1, 0xf1, 0x07, 0x02, 0x99, 0xa2, 0x61, 0xc1, 0xad,
+	0xbc, 0x42, 0x13, 0x26, 0x0c, 0x5e, 0x14, 0x6b, 0x87, 0x7b, 0x18, 0x71, 0xfc, 0x6f, 0xe8, 0xba,
+	0x0e, 0x6b, 0x39, 0x13, 0x26, 0xf5, 0x7c, 0xaf, 0x45, 0xcb, 0xbc, 0xef, 0x93, 0x00, 0x39, 0x38,
+	0xbe, 0x93, 0xee, 0x41, 0x49, 0x6c, 0xe5, 0x6b, 0xd4, 0xa3, 0x70, 0xff, 0x40, 0x5b, 0x1e, 0xb9,
+	0x76, 0x0a, 0xa9, 0x6b, 0x47, 0x6d, 0x7e, 0x39, 0x91, 0xda, 0x27, 0xe9, 0x7a, 0x63, 0x36, 0xdb,
+	0xdf, 0x94, 0xa0, 0xd0, 0xe6, 0x44, 0xff, 0x18, 0x16, 0x52, 0xaf, 0x86, 0x8d, 0xec, 0xb6, 0xcf,
+	0x5c, 0xcf, 0xe6, 0x6b, 0x13, 0x00, 0xc9, 0x49, 0xb3, 0x01, 0x46, 0xee, 0xee, 0xf5, 0x9c, 0xb0,
+	0xa1, 0xdb, 0xbc, 0x33, 0xd6, 0x9d, 0xe4, 0xdc, 0x87, 0xf9, 0xd1, 0xbb, 0xcf, 0x1a, 0x1b, 0xc5,
+	0xcd, 0x57, 0xc7, 0xfb, 0x93, 0xb4, 0x0f, 0xa1, 0x18, 0x5d, 0x29, 0xab, 0x39, 0x78, 0xe1, 0x30,
+	0x37, 0xfe, 0xc4, 0x91, 0x64, 0x78, 0x07, 0x0a, 0x51, 0x77, 0xcc, 0xc1, 0xd9, 0x7d, 0x6a, 0x5a,
+	0xf9, 0xe3, 0x49, 0xf8, 0xa7, 0xb0, 0x98, 0x69, 0x1f, 0xb7, 0xf3, 0x66, 0x4c, 0x41, 0xcc, 0xbb,
+	0x13, 0x21, 0x49, 0x7e, 0x02, 0x4b, 0x57, 0x3b, 0xc2, 0x2b, 0x39, 0xf1, 0x57, 0x50, 0xe6, 0x1b,
+	0xd7, 0x41, 0x25, 0x13, 0x39, 0x70, 0xf3, 0xca, 0x89, 0x7d, 0x39, 0x8f, 0x7c, 0x06, 0x64, 0xbe,
+	0x7e, 0x0d, 0xd0, 0xa8, 0x5c, 0x99, 0x63, 0x78, 0x3b, 0x77, 0x57, 0x8e, 0x42, 0xcc, 0xbb, 0x13,
+	0x21, 0x71, 0x7e, 0x73, 0xe6, 0x73, 0xf1, 0xac, 0xdd, 0x7d, 0xf7, 0xe9, 0xb9, 0xa5, 0x3d, 0x3b,
+	0xb7, 0xb4, 0xdf, 0xce, 0x2d, 0xed, 0xeb, 0x0b, 0x6b, 0xea, 0xd9, 0x85, 0x35, 0xf5, 0xcb, 0x85,
+	0x35, 0xf5, 0xc9, 0x1d, 0xe2, 0x86, 0x87, 0xfd, 0x83, 0x46, 0x97, 0x79, 0x4d, 0x97, 0x50, 0x37,
+	0xc4, 0xcd, 0xf4, 0x3b, 0x37, 0x3c, 0xf5, 0x31, 0x3f, 0x28, 0x45, 0xaf, 0xf3, 0xfb, 0x7f, 0x0c,
+	0x00, 0x56, 0x29, 0x24, 0x77, 0x7e, 0x0c, 0x00, 0x00,
 }
 
-func (m *MsgCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddPackage defines the AddPackage RPC.
+	AddPackage(ctx context.Context, in *MsgAddPackage, opts ...grpc.CallOption) (*MsgAddPackageResponse, error)
+	// AddPackages defines the AddPackages RPC.
+	AddPackages(ctx context.Context, in *MsgAddPackages, opts ...grpc.CallOption) (*MsgAddPackagesResponse, error)
+	// Call defines the Call RPC.
+	Call(ctx context.Context, in *MsgCall, opts ...grpc.CallOption) (*MsgCallResponse, error)
+	// Run defines the Run RPC.
+	Run(ctx context.Context, in *MsgRun, opts ...grpc.CallOption) (*MsgRunResponse, error)
+	// ClaimNamespace defines the ClaimNamespace RPC.
+	ClaimNamespace(ctx context.Context, in *MsgClaimNamespace, opts ...grpc.CallOption) (*MsgClaimNamespaceResponse, error)
+	// TransferNamespace defines the TransferNamespace RPC.
+	TransferNamespace(ctx context.Context, in *MsgTransferNamespace, opts ...grpc.CallOption) (*MsgTransferNamespaceResponse, error)
+	// ReleaseNamespace defines the ReleaseNamespace RPC.
+	ReleaseNamespace(ctx context.Context, in *MsgReleaseNamespace, opts ...grpc.CallOption) (*MsgReleaseNamespaceResponse, error)
+	// UpgradePackage defines the UpgradePackage RPC.
+	UpgradePackage(ctx context.Context, in *MsgUpgradePackage, opts ...grpc.CallOption) (*MsgUpgradePackageResponse, error)
 }
 
-func (m *MsgCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Function) > 0 {
-		i -= len(m.Function)
-		copy(dAtA[i:], m.Function)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Function)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PkgPath) > 0 {
-		i -= len(m.PkgPath)
-		copy(dAtA[i:], m.PkgPath)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PkgPath)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MaxDeposit) > 0 {
-		for iNdEx := len(m.MaxDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MaxDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Send) > 0 {
-		for iNdEx := len(m.Send) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Send[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgCallResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCallResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddPackage(ctx context.Context, in *MsgAddPackage, opts ...grpc.CallOption) (*MsgAddPackageResponse, error) {
+	out := new(MsgAddPackageResponse)
+	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/AddPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) AddPackages(ctx context.Context, in *MsgAddPackages, opts ...grpc.CallOption) (*MsgAddPackagesResponse, error) {
+	out := new(MsgAddPackagesResponse)
+	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/AddPackages", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRun) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Call(ctx context.Context, in *MsgCall, opts ...grpc.CallOption) (*MsgCallResponse, error) {
+	out := new(MsgCallResponse)
+	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/Call", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRun) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Run(ctx context.Context, in *MsgRun, opts ...grpc.CallOption) (*MsgRunResponse, error) {
+	out := new(MsgRunResponse)
+	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/Run", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pkg) > 0 {
-		i -= len(m.Pkg)
-		copy(dAtA[i:], m.Pkg)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Pkg)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MaxDeposit) > 0 {
-		for iNdEx := len(m.MaxDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MaxDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Send) > 0 {
-		for iNdEx := len(m.Send) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Send[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ClaimNamespace(ctx context.Context, in *MsgClaimNamespace, opts ...grpc.CallOption) (*MsgClaimNamespaceResponse, error) {
+	out := new(MsgClaimNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/ClaimNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRunResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferNamespace(ctx context.Context, in *MsgTransferNamespace, opts ...grpc.CallOption) (*MsgTransferNamespaceResponse, error) {
+	out := new(MsgTransferNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/TransferNamespace", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRunResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReleaseNamespace(ctx context.Context, in *MsgReleaseNamespace, opts ...grpc.CallOption) (*MsgReleaseNamespaceResponse, error) {
+	out := new(MsgReleaseNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/ReleaseNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpgradePackage(ctx context.Context, in *MsgUpgradePackage, opts ...grpc.CallOption) (*MsgUpgradePackageResponse, error) {
+	out := new(MsgUpgradePackageResponse)
+	err := c.cc.Invoke(ctx, "/gnovm.gnovm.v1.Msg/UpgradePackage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddPackage defines the AddPackage RPC.
+	AddPackage(context.Context, *MsgAddPackage) (*MsgAddPackageResponse, error)
+	// AddPackages defines the AddPackages RPC.
+	AddPackages(context.Context, *MsgAddPackages) (*MsgAddPackagesResponse, error)
+	// Call defines the Call RPC.
+	Call(context.Context, *MsgCall) (*MsgCallResponse, error)
+	// Run defines the Run RPC.
+	Run(context.Context, *MsgRun) (*MsgRunResponse, error)
+	// ClaimNamespace defines the ClaimNamespace RPC.
+	ClaimNamespace(context.Context, *MsgClaimNamespace) (*MsgClaimNamespaceResponse, error)
+	// TransferNamespace defines the TransferNamespace RPC.
+	TransferNamespace(context.Context, *MsgTransferNamespace) (*MsgTransferNamespaceResponse, error)
+	// ReleaseNamespace defines the ReleaseNamespace RPC.
+	ReleaseNamespace(context.Context, *MsgReleaseNamespace) (*MsgReleaseNamespaceResponse, error)
+	// UpgradePackage defines the UpgradePackage RPC.
+	UpgradePackage(context.Context, *MsgUpgradePackage) (*MsgUpgradePackageResponse, error)
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) AddPackage(ctx context.Context, req *MsgAddPackage) (*MsgAddPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPackage not implemented")
+}
+func (*UnimplementedMsgServer) AddPackages(ctx context.Context, req *MsgAddPackages) (*MsgAddPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPackages not implemented")
+}
+func (*UnimplementedMsgServer) Call(ctx context.Context, req *MsgCall) (*MsgCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+func (*UnimplementedMsgServer) Run(ctx context.Context, req *MsgRun) (*MsgRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Run not implemented")
+}
+func (*UnimplementedMsgServer) ClaimNamespace(ctx context.Context, req *MsgClaimNamespace) (*MsgClaimNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimNamespace not implemented")
+}
+func (*UnimplementedMsgServer) TransferNamespace(ctx context.Context, req *MsgTransferNamespace) (*MsgTransferNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNamespace not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseNamespace(ctx context.Context, req *MsgReleaseNamespace) (*MsgReleaseNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNamespace not implemented")
+}
+func (*UnimplementedMsgServer) UpgradePackage(ctx context.Context, req *MsgUpgradePackage) (*MsgUpgradePackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradePackage not implemented")
 }
 
-func (m *MsgAddPackage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Send) > 0 {
-		for _, e := range m.Send {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.MaxDeposit) > 0 {
-		for _, e := range m.MaxDeposit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnovm.gnovm.v1.Msg/UpdateParams",
 	}
-	l = len(m.Package)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddPackageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddPackage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AddPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnovm.gnovm.v1.Msg/AddPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddPackage(ctx, req.(*MsgAddPackage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCall) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddPackages)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddPackages(ctx, in)
 	}
-	if len(m.Send) > 0 {
-		for _, e := range m.Send {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnovm.gnovm.v1.Msg/AddPackages",
 	}
-	if len(m.MaxDeposit) > 0 {
-		for _, e := range m.MaxDeposit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddPackages(ctx, req.(*MsgAddPackages))
 	}
-	l = len(m.PkgPath)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCall)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Function)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Call(ctx, in)
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnovm.gnovm.v1.Msg/Call",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Call(ctx, req.(*MsgCall))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCallResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Run_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRun)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Run(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnovm.gnovm.v1.Msg/Run",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Run(ctx, req.(*MsgRun))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRun) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_ClaimNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Send) > 0 {
-		for _, e := range m.Send {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimNamespace(ctx, in)
 	}
-	if len(m.MaxDeposit) > 0 {
-		for _, e := range m.MaxDeposit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnovm.gnovm.v1.Msg/ClaimNamespace",
 	}
-	l = len(m.Pkg)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimNamespace(ctx, req.(*MsgClaimNamespace))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRunResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TransferNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnovm.gnovm.v1.Msg/TransferNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferNamespace(ctx, req.(*MsgTransferNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnovm.gnovm.v1.Msg/ReleaseNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseNamespace(ctx, req.(*MsgReleaseNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpgradePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpgradePackage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpgradePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnovm.gnovm.v1.Msg/UpgradePackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpgradePackage(ctx, req.(*MsgUpgradePackage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gnovm.gnovm.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddPackage",
+			Handler:    _Msg_AddPackage_Handler,
+		},
+		{
+			MethodName: "AddPackages",
+			Handler:    _Msg_AddPackages_Handler,
+		},
+		{
+			MethodName: "Call",
+			Handler:    _Msg_Call_Handler,
+		},
+		{
+			MethodName: "Run",
+			Handler:    _Msg_Run_Handler,
+		},
+		{
+			MethodName: "ClaimNamespace",
+			Handler:    _Msg_ClaimNamespace_Handler,
+		},
+		{
+			MethodName: "TransferNamespace",
+			Handler:    _Msg_TransferNamespace_Handler,
+		},
+		{
+			MethodName: "ReleaseNamespace",
+			Handler:    _Msg_ReleaseNamespace_Handler,
+		},
+		{
+			MethodName: "UpgradePackage",
+			Handler:    _Msg_UpgradePackage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gnovm/gnovm/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPackage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPackage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPackage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Package) > 0 {
+		i -= len(m.Package)
+		copy(dAtA[i:], m.Package)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Package)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxDeposit) > 0 {
+		for iNdEx := len(m.MaxDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Send) > 0 {
+		for iNdEx := len(m.Send) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Send[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPackageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPackageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPackageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPackages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPackages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPackages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Packages) > 0 {
+		for iNdEx := len(m.Packages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Packages[iNdEx])
+			copy(dAtA[i:], m.Packages[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Packages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxDeposit) > 0 {
+		for iNdEx := len(m.MaxDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPackagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPackagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPackagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PkgPaths) > 0 {
+		for iNdEx := len(m.PkgPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PkgPaths[iNdEx])
+			copy(dAtA[i:], m.PkgPaths[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PkgPaths[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JsonArgs) > 0 {
+		i -= len(m.JsonArgs)
+		copy(dAtA[i:], m.JsonArgs)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JsonArgs)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Function) > 0 {
+		i -= len(m.Function)
+		copy(dAtA[i:], m.Function)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Function)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PkgPath) > 0 {
+		i -= len(m.PkgPath)
+		copy(dAtA[i:], m.PkgPath)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PkgPath)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxDeposit) > 0 {
+		for iNdEx := len(m.MaxDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Send) > 0 {
+		for iNdEx := len(m.Send) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Send[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pkg) > 0 {
+		i -= len(m.Pkg)
+		copy(dAtA[i:], m.Pkg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pkg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxDeposit) > 0 {
+		for iNdEx := len(m.MaxDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Send) > 0 {
+		for iNdEx := len(m.Send) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Send[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRunResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpgradePackage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpgradePackage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpgradePackage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Package) > 0 {
+		i -= len(m.Package)
+		copy(dAtA[i:], m.Package)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Package)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxDeposit) > 0 {
+		for iNdEx := len(m.MaxDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpgradePackageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpgradePackageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpgradePackageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddPackage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Send) > 0 {
+		for _, e := range m.Send {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxDeposit) > 0 {
+		for _, e := range m.MaxDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddPackageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddPackages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MaxDeposit) > 0 {
+		for _, e := range m.MaxDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Packages) > 0 {
+		for _, b := range m.Packages {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddPackagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PkgPaths) > 0 {
+		for _, s := range m.PkgPaths {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Send) > 0 {
+		for _, e := range m.Send {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxDeposit) > 0 {
+		for _, e := range m.MaxDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.PkgPath)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Function)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.JsonArgs)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Send) > 0 {
+		for _, e := range m.Send {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxDeposit) > 0 {
+		for _, e := range m.MaxDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Pkg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRunResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpgradePackage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MaxDeposit) > 0 {
+		for _, e := range m.MaxDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpgradePackageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPackage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPackage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPackage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Send", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Send = append(m.Send, types.Coin{})
+			if err := m.Send[len(m.Send)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDeposit = append(m.MaxDeposit, types.Coin{})
+			if err := m.MaxDeposit[len(m.MaxDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Package = append(m.Package[:0], dAtA[iNdEx:postIndex]...)
+			if m.Package == nil {
+				m.Package = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPackageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPackageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPackages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPackages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPackages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDeposit = append(m.MaxDeposit, types.Coin{})
+			if err := m.MaxDeposit[len(m.MaxDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packages", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packages = append(m.Packages, make([]byte, postIndex-iNdEx))
+			copy(m.Packages[len(m.Packages)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPackagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPackagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPackagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkgPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkgPaths = append(m.PkgPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Send", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Send = append(m.Send, types.Coin{})
+			if err := m.Send[len(m.Send)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDeposit = append(m.MaxDeposit, types.Coin{})
+			if err := m.MaxDeposit[len(m.MaxDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkgPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkgPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Function = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonArgs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, TypedValue{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1285,15 +3717,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1321,11 +3753,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Caller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Send", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1352,60 +3784,79 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Send = append(m.Send, types.Coin{})
+			if err := m.Send[len(m.Send)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.MaxDeposit = append(m.MaxDeposit, types.Coin{})
+			if err := m.MaxDeposit[len(m.MaxDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Pkg = append(m.Pkg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pkg == nil {
+				m.Pkg = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1427,7 +3878,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddPackage) Unmarshal(dAtA []byte) error {
+func (m *MsgRunResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1450,15 +3901,15 @@ func (m *MsgAddPackage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddPackage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRunResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddPackage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1486,11 +3937,11 @@ func (m *MsgAddPackage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Result = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Send", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1517,16 +3968,66 @@ func (m *MsgAddPackage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Send = append(m.Send, types.Coin{})
-			if err := m.Send[len(m.Send)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, TypedValue{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1536,31 +4037,29 @@ func (m *MsgAddPackage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDeposit = append(m.MaxDeposit, types.Coin{})
-			if err := m.MaxDeposit[len(m.MaxDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1570,25 +4069,23 @@ func (m *MsgAddPackage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Package = append(m.Package[:0], dAtA[iNdEx:postIndex]...)
-			if m.Package == nil {
-				m.Package = []byte{}
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1611,7 +4108,7 @@ func (m *MsgAddPackage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddPackageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimNamespaceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1634,10 +4131,10 @@ func (m *MsgAddPackageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddPackageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimNamespaceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddPackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1661,7 +4158,7 @@ func (m *MsgAddPackageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCall) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferNamespace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1684,49 +4181,17 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCall: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferNamespace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCall: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Send", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1736,31 +4201,29 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Send = append(m.Send, types.Coin{})
-			if err := m.Send[len(m.Send)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1770,29 +4233,27 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDeposit = append(m.MaxDeposit, types.Coin{})
-			if err := m.MaxDeposit[len(m.MaxDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PkgPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1820,11 +4281,111 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PkgPath = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1852,11 +4413,11 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Function = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1884,7 +4445,7 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1907,7 +4468,7 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCallResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseNamespaceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1930,44 +4491,12 @@ func (m *MsgCallResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCallResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseNamespaceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1989,7 +4518,7 @@ func (m *MsgCallResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRun) Unmarshal(dAtA []byte) error {
+func (m *MsgUpgradePackage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2012,15 +4541,15 @@ func (m *MsgRun) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRun: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpgradePackage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRun: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpgradePackage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2048,43 +4577,9 @@ func (m *MsgRun) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Send", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Send = append(m.Send, types.Coin{})
-			if err := m.Send[len(m.Send)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
 			}
@@ -2118,9 +4613,9 @@ func (m *MsgRun) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2147,9 +4642,9 @@ func (m *MsgRun) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pkg = append(m.Pkg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Pkg == nil {
-				m.Pkg = []byte{}
+			m.Package = append(m.Package[:0], dAtA[iNdEx:postIndex]...)
+			if m.Package == nil {
+				m.Package = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2173,7 +4668,7 @@ func (m *MsgRun) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRunResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpgradePackageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2196,44 +4691,12 @@ func (m *MsgRunResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRunResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpgradePackageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpgradePackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// TypedValuesFromGno decodes the values returned by the VM, printed one per
+// line as "(<value> <type>)".
+func TypedValuesFromGno(result string) []TypedValue {
+	// call results are terminated by an empty line
+	result = strings.TrimRight(result, "\n")
+	if result == "" {
+		return []TypedValue{}
+	}
+
+	lines := strings.Split(result, "\n")
+	values := make([]TypedValue, 0, len(lines))
+	for _, line := range lines {
+		values = append(values, typedValueFromGno(line))
+	}
+
+	return values
+}
+
+// NewStringTypedValue returns the TypedValue of a string.
+func NewStringTypedValue(s string) TypedValue {
+	bz, _ := json.Marshal(s)
+	return TypedValue{Type: "string", JsonValue: string(bz)}
+}
+
+func typedValueFromGno(line string) TypedValue {
+	if !strings.HasPrefix(line, "(") || !strings.HasSuffix(line, ")") {
+		return TypedValue{}
+	}
+	inner := line[1 : len(line)-1]
+
+	// the value ends at the first space which is not nested in a string,
+	// a composite literal or a nested typed value
+	end := len(inner)
+	depth := 0
+scan:
+	for i := 0; i < len(inner); i++ {
+		switch inner[i] {
+		case '"':
+			quoted, err := strconv.QuotedPrefix(inner[i:])
+			if err != nil {
+				return TypedValue{}
+			}
+			i += len(quoted) - 1
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case ' ':
+			if depth == 0 {
+				end = i
+				break scan
+			}
+		}
+	}
+
+	value, typ := inner[:end], ""
+	if end < len(inner) {
+		typ = inner[end+1:]
+	}
+
+	return TypedValue{Type: typ, JsonValue: jsonValueFromGno(value, typ)}
+}
+
+// jsonValueFromGno encodes a primitive value or a byte slice printed by the
+// VM to JSON, it returns an empty string for other composite values.
+func jsonValueFromGno(value, typ string) string {
+	switch {
+	case value == "":
+		// empty strings are printed unquoted
+		if typ == "string" {
+			return `""`
+		}
+		return ""
+	case value == "nil":
+		return "null"
+	case value == "true" || value == "false":
+		return value
+	case value[0] == '"':
+		s, err := strconv.Unquote(value)
+		if err != nil {
+			return ""
+		}
+		bz, _ := json.Marshal(s)
+		return string(bz)
+	case strings.HasSuffix(typ, "]uint8"):
+		// byte slices and arrays are printed in hex, and encoded in base64
+		// as the call arguments
+		hexBytes, ok := strings.CutPrefix(strings.TrimSuffix(value, "]"), "slice[0x")
+		if !ok {
+			hexBytes, ok = strings.CutPrefix(strings.TrimSuffix(value, "]"), "array[0x")
+		}
+		bz, err := hex.DecodeString(hexBytes)
+		if !ok || err != nil {
+			return ""
+		}
+		bz, _ = json.Marshal(bz)
+		return string(bz)
+	}
+
+	if _, ok := new(big.Int).SetString(value, 10); ok {
+		switch typ {
+		case "int8", "int16", "int32", "uint8", "uint16", "uint32":
+			return value
+		default:
+			// larger integers would lose precision in JavaScript clients
+			bz, _ := json.Marshal(value)
+			return string(bz)
+		}
+	}
+
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		bz, err := json.Marshal(f)
+		if err != nil {
+			// NaN and infinities have no JSON number encoding
+			bz, _ = json.Marshal(value)
+		}
+		return string(bz)
+	}
+
+	return ""
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/gnovm/x/gnovm/types"
+)
+
+func TestTypedValuesFromGno(t *testing.T) {
+	tests := []struct {
+		desc     string
+		result   string
+		expected []types.TypedValue
+	}{
+		{
+			desc:     "no result",
+			result:   "\n\n",
+			expected: []types.TypedValue{},
+		},
+		{
+			desc:   "primitive values",
+			result: "(true bool)\n(\"foo \\\"bar\\\"\" string)\n(-42 int32)\n(42 uint64)\n(1.5 float64)\n\n",
+			expected: []types.TypedValue{
+				{Type: "bool", JsonValue: "true"},
+				{Type: "string", JsonValue: `"foo \"bar\""`},
+				{Type: "int32", JsonValue: "-42"},
+				{Type: "uint64", JsonValue: `"42"`},
+				{Type: "float64", JsonValue: "1.5"},
+			},
+		},
+		{
+			desc:     "empty string",
+			result:   "( string)",
+			expected: []types.TypedValue{{Type: "string", JsonValue: `""`}},
+		},
+		{
+			desc:   "declared types",
+			result: "(\"a b\" gno.land/r/demo/foo.ID)\n(nil *gno.land/r/demo/foo.Bar)",
+			expected: []types.TypedValue{
+				{Type: "gno.land/r/demo/foo.ID", JsonValue: `"a b"`},
+				{Type: "*gno.land/r/demo/foo.Bar", JsonValue: "null"},
+			},
+		},
+		{
+			desc:   "bytes",
+			result: "(slice[0x6869] []uint8)\n(array[0x6869] [2]uint8)",
+			expected: []types.TypedValue{
+				{Type: "[]uint8", JsonValue: `"aGk="`},
+				{Type: "[2]uint8", JsonValue: `"aGk="`},
+			},
+		},
+		{
+			desc:     "composite value",
+			result:   "(struct{(1 int),(\"a b\" string)} gno.land/r/demo/foo.Pair)",
+			expected: []types.TypedValue{{Type: "gno.land/r/demo/foo.Pair"}},
+		},
+		{
+			desc:     "composite type",
+			result:   "(struct{(1 int)} struct{A int})",
+			expected: []types.TypedValue{{Type: "struct{A int}"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.TypedValuesFromGno(tc.result))
+		})
+	}
+}